@@ -1,11 +1,15 @@
 package bindly
 
 import (
+	"fmt"
 	"github.com/viant/bindly/locator"
 	"github.com/viant/bindly/state"
 	"github.com/viant/bindly/xform"
 	"github.com/viant/structology"
 	"github.com/viant/tagly/tags"
+	"reflect"
+	"strings"
+	"unsafe"
 )
 
 // Binding represents a binding
@@ -15,7 +19,82 @@ type Binding struct {
 	provider     locator.Provider
 	cachable     bool
 	required     bool
+	secret       bool
+	all          bool   //collects all locator values assignable to slice or map element
+	qualifier    string //interface implementation name
+	lazy         bool   //field is func() (T, error) accessor resolving value on call
+	optional     bool   //field is Optional[T] recording value presence
 	defaultValue interface{}
 	transformer  xform.Transformer
 	xformConfig  tags.Values
+	ancestors    []*structology.Selector //nested struct pointers on selector path
+}
+
+// valueType returns bound value type, lazy accessor binding resolves accessor result type
+func (b *Binding) valueType() reflect.Type {
+	if b.lazy {
+		return b.selector.Type().Out(0)
+	}
+	if b.optional {
+		return reflect.Zero(b.selector.Type()).Interface().(optional).valueType()
+	}
+	return b.selector.Type()
+}
+
+// fieldValue returns field value for resolved value, Optional field records value presence
+func (b *Binding) fieldValue(value interface{}, ok bool) (interface{}, bool) {
+	if !b.optional {
+		return value, ok
+	}
+	if !ok {
+		return reflect.Zero(b.selector.Type()).Interface(), true
+	}
+	return newOptional(b.selector.Type(), value, b.location.String()), true
+}
+
+// ensureAncestors allocates nil struct pointers on nested selector path, unexported pointers are allocated only if allowed
+func (b *Binding) ensureAncestors(aState *structology.State, allocateUnexported bool) error {
+	for _, ancestor := range b.ancestors {
+		field, ok := fieldByPath(aState, ancestor.Path())
+		if !ok {
+			return fmt.Errorf("failed to allocate: %v", ancestor.Path())
+		}
+		if !field.CanSet() && field.IsNil() {
+			if !allocateUnexported || !field.CanAddr() {
+				return fmt.Errorf("failed to allocate: %v, unexported field allocation requires WithUnexportedAllocation option", ancestor.Path())
+			}
+			field = reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+		}
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+	}
+	return nil
+}
+
+// isReachable returns true if none of struct pointers on nested selector path is nil
+func (b *Binding) isReachable(aState *structology.State) bool {
+	for _, ancestor := range b.ancestors {
+		if field, ok := fieldByPath(aState, ancestor.Path()); !ok || field.IsNil() {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldByPath returns state struct field for dotted path, ok is false if path crosses nil pointer
+func fieldByPath(aState *structology.State, aPath string) (reflect.Value, bool) {
+	value := reflect.ValueOf(aState.State())
+	for _, name := range strings.Split(aPath, ".") {
+		for value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				return value, false
+			}
+			value = value.Elem()
+		}
+		if value = value.FieldByName(name); !value.IsValid() {
+			return value, false
+		}
+	}
+	return value, true
 }
@@ -58,7 +58,7 @@ func (b Bindings) GroupByPriority(registry *locator.Registry) ([]Bindings, error
 	return result, nil
 }
 
-func (b *Injector) buildBindings(ctx context.Context, destState *structology.StateType) (*BindingType, error) {
+func (b *Injector) buildBindings(ctx context.Context, destState *structology.StateType, prefix string) (*BindingType, error) {
 	rootSelector := destState.RootSelectors()
 	if len(rootSelector) == 0 {
 		return nil, fmt.Errorf("invalid type: %s", destState.Type().String())
@@ -68,34 +68,130 @@ func (b *Injector) buildBindings(ctx context.Context, destState *structology.Sta
 		embedFs = b.embedder.EmbedFS()
 	}
 	var bindings Bindings
-	for i, selector := range rootSelector {
+	if err := b.appendBindings(ctx, destState, rootSelector, &bindingScope{prefix: prefix}, embedFs, &bindings); err != nil {
+		return nil, err
+	}
+	groups, err := bindings.GroupByPriority(b.locators)
+	if err != nil {
+		return nil, err
+	}
+	return &BindingType{
+		Bindings: groups,
+		Type:     destState,
+	}, nil
+}
+
+// bindingScope represents nested struct traversal scope
+type bindingScope struct {
+	ancestors []*structology.Selector //struct pointers on selector path
+	prefix    string                  //prepended to binding location in
+}
+
+// nest returns scope for selector nested struct fields
+func (s *bindingScope) nest(selector *structology.Selector, prefix string) *bindingScope {
+	ret := &bindingScope{ancestors: s.ancestors, prefix: joinPrefix(s.prefix, prefix)}
+	if selector.Type().Kind() == reflect.Ptr {
+		ret.ancestors = append(append([]*structology.Selector{}, s.ancestors...), selector)
+	}
+	return ret
+}
+
+// appendBindings appends selector bindings, untagged nested and embedded structs are traversed when enabled,
+// nested structs tagged with prefix are always traversed
+func (b *Injector) appendBindings(ctx context.Context, destState *structology.StateType, selectors []*structology.Selector, scope *bindingScope, embedFs *embed.FS, bindings *Bindings) error {
+	for i, selector := range selectors {
 		tag := selector.Tag()
-		_, ok := tag.Lookup(b.bindingTag)
-		aBinding := &Binding{location: &state.Location{}, selector: rootSelector[i]}
+		bindTag, ok := tag.Lookup(b.bindingTag)
+		if prefix, hasPrefix := bindingPrefix(bindTag); ok && hasPrefix {
+			if !isNestedStruct(selector) {
+				return fmt.Errorf("invalid prefix binding: %v, expected nested struct but had %v", selector.Path(), selector.Type())
+			}
+			if err := b.appendBindings(ctx, destState, selector.Selectors.Root, scope.nest(selector, prefix), embedFs, bindings); err != nil {
+				return err
+			}
+			continue
+		}
+		aBinding := &Binding{location: &state.Location{}, selector: selectors[i], ancestors: scope.ancestors, lazy: isLazyType(selector.Type()), optional: isOptionalType(selector.Type())}
+		fieldConfig, hasConfig := b.fieldConfigs.Lookup(destState.Type(), selector.Path())
 		if !ok {
+			fieldConfig, hasConfig = b.manifestConfig(destState.Type(), selector, fieldConfig)
+		}
+		if !ok && !(hasConfig && fieldConfig.hasLocation()) {
+			if hasConfig && fieldConfig.hasTransform() {
+				return fmt.Errorf("transformer configured for unbound field: %v", selector.Path())
+			}
+			if b.nested && isNestedStruct(selector) {
+				if err := b.appendBindings(ctx, destState, selector.Selectors.Root, scope.nest(selector, ""), embedFs, bindings); err != nil {
+					return err
+				}
+				continue
+			}
 			if selector.Type().Kind() == reflect.Interface {
 				aBinding.location.In = selector.Type().String()
 				aBinding.location.Kind = b.interfaceKind
-				bindings = append(bindings, aBinding)
+				*bindings = append(*bindings, aBinding)
 			}
 			continue
 		}
-		if err := b.extractTransformer(ctx, aBinding, embedFs); err != nil {
-			return nil, err
+		if err := b.extractTransformer(ctx, aBinding, fieldConfig, embedFs); err != nil {
+			return err
 		}
 
 		b.extractBinding(aBinding)
+		if hasConfig {
+			fieldConfig.apply(aBinding)
+		}
+		if aBinding.all {
+			if err := checkCollection(selector, aBinding.valueType()); err != nil {
+				return err
+			}
+			if aBinding.location.Kind == "" {
+				aBinding.location.Kind = b.interfaceKind
+			}
+		}
 		if aBinding.location.Kind == "" && aBinding.location.In == "" {
-			return nil, fmt.Errorf("binding location was empty for: %v", selector.Path())
+			return fmt.Errorf("binding location was empty for: %v", selector.Path())
+		}
+		if aBinding.location.In != "" {
+			aBinding.location.In = joinPrefix(scope.prefix, aBinding.location.In)
 		}
-		bindings = append(bindings, aBinding)
+		if aBinding.qualifier != "" && !b.isQualifiable(aBinding.location.Kind) {
+			return fmt.Errorf("invalid binding: %v, name qualifier is not supported by %v kind", selector.Path(), aBinding.location.Kind)
+		}
+		if aBinding.location.In == "" && !aBinding.all && (aBinding.qualifier != "" || aBinding.location.Kind == b.interfaceKind) {
+			aBinding.location.In = aBinding.valueType().String()
+		}
+		aBinding.location.In = locator.QualifiedName(aBinding.location.In, aBinding.qualifier)
+		*bindings = append(*bindings, aBinding)
 	}
-	groups, err := bindings.GroupByPriority(b.locators)
-	if err != nil {
-		return nil, err
+	return nil
+}
+
+// isQualifiable returns true if kind supports name qualifier, i.e. interface kind or provider matching by type
+func (b *Injector) isQualifiable(kind string) bool {
+	if kind == b.interfaceKind {
+		return true
 	}
-	return &BindingType{
-		Bindings: groups,
-		Type:     destState,
-	}, nil
+	provider, ok := b.locators.Lookup(kind)
+	if !ok {
+		return false
+	}
+	_, ok = provider.(locator.Matcher)
+	return ok
+}
+
+// joinPrefix joins location prefix with name using . separator
+func joinPrefix(prefix, name string) string {
+	switch {
+	case prefix == "":
+		return name
+	case name == "":
+		return prefix
+	}
+	return prefix + "." + name
+}
+
+// isNestedStruct returns true if selector is a struct or struct pointer with traversable fields
+func isNestedStruct(selector *structology.Selector) bool {
+	return isStructTarget(selector.Type()) && !isOptionalType(selector.Type()) && len(selector.Selectors.Root) > 0
 }
@@ -0,0 +1,74 @@
+package bindly
+
+import "github.com/viant/bindly/xform"
+
+type (
+	// TypeBuilder declares T bindings without struct tags, i.e. for third-party or generated types
+	TypeBuilder[T any] struct {
+		injector *Injector
+		err      error
+	}
+
+	// FieldBuilder declares field binding, each call is merged into T binding configuration
+	FieldBuilder[T any] struct {
+		*TypeBuilder[T]
+		path string
+	}
+)
+
+// For creates T binding builder, i.e. bindly.For[Service](injector).Field("Port").From("setting", "port").Required()
+func For[T any](injector *Injector) *TypeBuilder[T] {
+	return &TypeBuilder[T]{injector: injector}
+}
+
+// Field returns field binding builder for supplied selector path
+func (b *TypeBuilder[T]) Field(path string) *FieldBuilder[T] {
+	return &FieldBuilder[T]{TypeBuilder: b, path: path}
+}
+
+// Err returns the first configuration error
+func (b *TypeBuilder[T]) Err() error {
+	return b.err
+}
+
+// From sets binding source location
+func (f *FieldBuilder[T]) From(kind, in string) *FieldBuilder[T] {
+	return f.configure(&FieldConfig{Kind: kind, In: in})
+}
+
+// Required fails injection if source value is not found
+func (f *FieldBuilder[T]) Required() *FieldBuilder[T] {
+	required := true
+	return f.configure(&FieldConfig{Required: &required})
+}
+
+// Cacheable caches resolved value in binding context value cache
+func (f *FieldBuilder[T]) Cacheable() *FieldBuilder[T] {
+	cacheable := true
+	return f.configure(&FieldConfig{Cacheable: &cacheable})
+}
+
+// Secret redacts value in Diff result
+func (f *FieldBuilder[T]) Secret() *FieldBuilder[T] {
+	secret := true
+	return f.configure(&FieldConfig{Secret: &secret})
+}
+
+// Default sets value used when source value is not found
+func (f *FieldBuilder[T]) Default(value interface{}) *FieldBuilder[T] {
+	return f.configure(&FieldConfig{Default: value})
+}
+
+// Transform sets field transformers, more than one config composes a pipeline
+func (f *FieldBuilder[T]) Transform(configs ...xform.Config) *FieldBuilder[T] {
+	return f.configure(Field(f.path).Transform(configs...))
+}
+
+func (f *FieldBuilder[T]) configure(config *FieldConfig) *FieldBuilder[T] {
+	if f.err != nil {
+		return f
+	}
+	config.Path = f.path
+	f.err = Configure[T](f.injector, config)
+	return f
+}
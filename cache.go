@@ -89,12 +89,27 @@ func NewValueCache() *ValueCache {
 	return &ValueCache{Map: internal.NewMap[string, interface{}](), fs: afs.New(), locker: internal.NewMap[string, sync.Locker]()}
 }
 
+// BindingKey represents binding type cache key, the same type bound under different location prefix has distinct bindings
+type BindingKey struct {
+	Type   reflect.Type
+	Prefix string
+}
+
 type BindingCache struct {
-	internal.Map[reflect.Type, *BindingType]
+	internal.Map[BindingKey, *BindingType]
+}
+
+// DeleteType removes cached bindings of supplied type for all prefixes
+func (c *BindingCache) DeleteType(t reflect.Type) {
+	for _, key := range c.Keys() {
+		if key.Type == t {
+			c.Delete(key)
+		}
+	}
 }
 
 func NewBindingCache() *BindingCache {
-	return &BindingCache{Map: internal.NewMap[reflect.Type, *BindingType]()}
+	return &BindingCache{Map: internal.NewMap[BindingKey, *BindingType]()}
 }
 
 type StructTypeCache struct {
@@ -0,0 +1,61 @@
+package bindly
+
+import (
+	"context"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/structology"
+	"reflect"
+)
+
+// checkCollection checks that collection binding selector is a slice or a map with string key
+func checkCollection(selector *structology.Selector, fieldType reflect.Type) error {
+	switch fieldType.Kind() {
+	case reflect.Slice:
+		return nil
+	case reflect.Map:
+		if fieldType.Key().Kind() == reflect.String {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid collection binding: %v, expected slice or map with string key but had %v", selector.Path(), fieldType)
+}
+
+// collectValue returns slice or map of all locator values assignable to selector element type, values are ordered by name
+func (c *BindingContext[T]) collectValue(ctx context.Context, binding *Binding, aLocator locator.Locator) (interface{}, bool, error) {
+	enumerator, ok := aLocator.(locator.Enumerator)
+	if !ok {
+		return nil, false, fmt.Errorf("locator %v does not support collection binding: %v", binding.location.Kind, binding.selector.Path())
+	}
+	entries, err := enumerator.Entries(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	collectionType := binding.valueType()
+	elemType := collectionType.Elem()
+	var matched []*locator.Entry
+	for _, entry := range entries {
+		if entry.Value != nil && reflect.TypeOf(entry.Value).AssignableTo(elemType) {
+			matched = append(matched, entry)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, false, nil
+	}
+	if collectionType.Kind() == reflect.Map {
+		result := reflect.MakeMapWithSize(collectionType, len(matched))
+		for _, entry := range matched {
+			key := reflect.ValueOf(entry.Name).Convert(collectionType.Key())
+			if result.MapIndex(key).IsValid() {
+				return nil, false, fmt.Errorf("failed to collect %v: duplicate name %v", binding.selector.Path(), entry.Name)
+			}
+			result.SetMapIndex(key, reflect.ValueOf(entry.Value))
+		}
+		return result.Interface(), true, nil
+	}
+	result := reflect.MakeSlice(collectionType, 0, len(matched))
+	for _, entry := range matched {
+		result = reflect.Append(result, reflect.ValueOf(entry.Value))
+	}
+	return result.Interface(), true, nil
+}
@@ -0,0 +1,202 @@
+package bindly
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/viant/afs"
+	"github.com/viant/bindly/internal"
+	"github.com/viant/bindly/state"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/structology"
+	"github.com/viant/tagly/tags"
+	"gopkg.in/yaml.v3"
+	"path"
+	"reflect"
+	"strings"
+)
+
+type (
+	// FieldConfig represents field binding configuration declared outside struct tags, it takes precedence over tags
+	FieldConfig struct {
+		Path       string          `json:"path" yaml:"path"`
+		Kind       string          `json:"kind,omitempty" yaml:"kind,omitempty"`
+		In         string          `json:"in,omitempty" yaml:"in,omitempty"`
+		Required   *bool           `json:"required,omitempty" yaml:"required,omitempty"`
+		Cacheable  *bool           `json:"cacheable,omitempty" yaml:"cacheable,omitempty"`
+		Secret     *bool           `json:"secret,omitempty" yaml:"secret,omitempty"`
+		Default    interface{}     `json:"default,omitempty" yaml:"default,omitempty"`
+		Transforms []*xform.Config `json:"transform,omitempty" yaml:"transform,omitempty"`
+		XForm      string          `json:"xform,omitempty" yaml:"xform,omitempty"`
+	}
+
+	// TypeConfig represents type binding configuration, i.e. loaded from JSON or YAML file
+	TypeConfig struct {
+		Fields []*FieldConfig `json:"fields" yaml:"fields"`
+	}
+
+	// FieldConfigs represents field configurations keyed by struct type and selector path
+	FieldConfigs struct {
+		internal.Map[reflect.Type, map[string]*FieldConfig]
+	}
+)
+
+// Field creates field configuration for supplied selector path
+func Field(path string) *FieldConfig {
+	return &FieldConfig{Path: path}
+}
+
+// Transform sets field transformers, more than one config composes a pipeline
+func (f *FieldConfig) Transform(configs ...xform.Config) *FieldConfig {
+	f.Transforms = make([]*xform.Config, len(configs))
+	for i := range configs {
+		f.Transforms[i] = &configs[i]
+	}
+	return f
+}
+
+// merge merges supplied config, explicitly set values win
+func (f *FieldConfig) merge(config *FieldConfig) {
+	if config.Kind != "" {
+		f.Kind = config.Kind
+	}
+	if config.In != "" {
+		f.In = config.In
+	}
+	if config.Required != nil {
+		f.Required = config.Required
+	}
+	if config.Cacheable != nil {
+		f.Cacheable = config.Cacheable
+	}
+	if config.Secret != nil {
+		f.Secret = config.Secret
+	}
+	if config.Default != nil {
+		f.Default = config.Default
+	}
+	if len(config.Transforms) > 0 {
+		f.Transforms, f.XForm = config.Transforms, ""
+	}
+	if config.XForm != "" {
+		f.XForm, f.Transforms = config.XForm, nil
+	}
+}
+
+// hasTransform returns true if config declares transformer
+func (f *FieldConfig) hasTransform() bool {
+	return len(f.Transforms) > 0 || f.XForm != ""
+}
+
+// transformTag returns transformer tag value
+func (f *FieldConfig) transformTag() tags.Values {
+	if len(f.Transforms) > 0 {
+		return xform.PipelineTag(f.Transforms...)
+	}
+	return tags.Values(f.XForm)
+}
+
+// hasLocation returns true if config declares binding source
+func (f *FieldConfig) hasLocation() bool {
+	return f.Kind != "" || f.In != ""
+}
+
+// apply overrides binding with explicitly configured values
+func (f *FieldConfig) apply(aBinding *Binding) {
+	if f.Kind != "" {
+		aBinding.location.Kind = f.Kind
+	}
+	if f.In != "" {
+		aBinding.location.In = f.In
+	}
+	if aBinding.location.Kind == "" && aBinding.location.In != "" {
+		aBinding.location.Kind = state.DefaultKind
+	}
+	if f.Required != nil {
+		aBinding.required = *f.Required
+	}
+	if f.Cacheable != nil {
+		aBinding.cachable = *f.Cacheable
+	}
+	if f.Secret != nil {
+		aBinding.secret = *f.Secret
+	}
+	if f.Default != nil {
+		aBinding.defaultValue = f.Default
+	}
+}
+
+// Lookup returns field configuration for supplied struct type and selector path
+func (c *FieldConfigs) Lookup(structType reflect.Type, aPath string) (*FieldConfig, bool) {
+	fields, ok := c.Get(ensureStructType(structType))
+	if !ok {
+		return nil, false
+	}
+	ret, ok := fields[aPath]
+	return ret, ok
+}
+
+// NewFieldConfigs creates field configurations
+func NewFieldConfigs() *FieldConfigs {
+	return &FieldConfigs{Map: internal.NewMap[reflect.Type, map[string]*FieldConfig]()}
+}
+
+// Configure attaches field configurations to T bindings, configuration merges with struct tags and explicit configuration wins
+func Configure[T any](injector *Injector, fields ...*FieldConfig) error {
+	targetType := reflect.TypeOf((*T)(nil))
+	stateType := structology.NewStateType(targetType)
+	structType := ensureStructType(targetType)
+	configs, _ := injector.fieldConfigs.Get(structType)
+	merged := make(map[string]*FieldConfig, len(configs)+len(fields))
+	for k, v := range configs {
+		merged[k] = v
+	}
+	for _, field := range fields {
+		if stateType.Lookup(field.Path) == nil {
+			return fmt.Errorf("failed to configure %v: unknown field %v", structType, field.Path)
+		}
+		clone := *field
+		if prev, ok := merged[field.Path]; ok {
+			clone = *prev
+			clone.merge(field)
+		}
+		merged[field.Path] = &clone
+	}
+	injector.fieldConfigs.Put(structType, merged)
+	injector.bindingCache.DeleteType(targetType)
+	return nil
+}
+
+// ConfigureFromURL loads TypeConfig from JSON or YAML (.yaml, .yml) file and attaches it to T bindings
+func ConfigureFromURL[T any](ctx context.Context, injector *Injector, URL string) error {
+	config := &TypeConfig{}
+	if err := loadURL(ctx, URL, config); err != nil {
+		return err
+	}
+	return Configure[T](injector, config.Fields...)
+}
+
+// loadURL decodes JSON or YAML (.yaml, .yml) file into target
+func loadURL(ctx context.Context, URL string, target interface{}) error {
+	data, err := afs.New().DownloadWithURL(ctx, URL)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %v, %w", URL, err)
+	}
+	switch strings.ToLower(path.Ext(URL)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, target)
+	default:
+		err = json.Unmarshal(data, target)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode config: %v, %w", URL, err)
+	}
+	return nil
+}
+
+func ensureStructType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
@@ -11,6 +11,7 @@ type BindingContext[T any] struct {
 	state      *structology.State
 	bindings   []Bindings
 	valueCache *ValueCache
+	prefix     string
 }
 
 func WithState[T any](binder *Injector, state interface{}, opt ...BindingOption[T]) *BindingContext[T] {
@@ -0,0 +1,105 @@
+package bindly
+
+import (
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+)
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	flagValueType       = reflect.TypeOf((*flag.Value)(nil)).Elem()
+)
+
+// convertValue converts value to target type using encoding aware unmarshalers, map element conversion
+// or conversion between types sharing the same underlying kind, it returns false if no conversion applies
+func convertValue(targetType reflect.Type, value interface{}, adjustElement func(reflect.Type, interface{}) (interface{}, error)) (interface{}, bool, error) {
+	if text, ok := asText(value); ok {
+		if result, ok, err := unmarshalText(targetType, text, reflect.TypeOf(value).Kind() == reflect.Slice); ok || err != nil {
+			return result, ok, err
+		}
+	}
+	valueType := reflect.TypeOf(value)
+	if targetType.Kind() == reflect.Map && valueType.Kind() == reflect.Map {
+		result, err := convertMap(targetType, value, adjustElement)
+		return result, err == nil, err
+	}
+	if targetType.Kind() == valueType.Kind() && valueType.ConvertibleTo(targetType) {
+		return reflect.ValueOf(value).Convert(targetType).Interface(), true, nil
+	}
+	return nil, false, nil
+}
+
+// asText returns text of string or []byte based value
+func asText(value interface{}) ([]byte, bool) {
+	rValue := reflect.ValueOf(value)
+	switch rValue.Kind() {
+	case reflect.String:
+		return []byte(rValue.String()), true
+	case reflect.Slice:
+		if rValue.Type().Elem().Kind() == reflect.Uint8 {
+			return rValue.Bytes(), true
+		}
+	}
+	return nil, false
+}
+
+// unmarshalText decodes text with encoding.TextUnmarshaler, json.Unmarshaler or flag.Value implemented by target type,
+// raw []byte text is passed to json.Unmarshaler as is, string text is decoded as JSON string
+func unmarshalText(targetType reflect.Type, text []byte, raw bool) (interface{}, bool, error) {
+	destType := targetType
+	if destType.Kind() == reflect.Ptr {
+		destType = destType.Elem()
+	}
+	ptrType := reflect.PtrTo(destType)
+	var err error
+	dest := reflect.New(destType)
+	switch {
+	case ptrType.Implements(textUnmarshalerType):
+		err = dest.Interface().(encoding.TextUnmarshaler).UnmarshalText(text)
+	case ptrType.Implements(jsonUnmarshalerType):
+		data := text
+		if !raw {
+			if data, err = json.Marshal(string(text)); err != nil {
+				return nil, false, err
+			}
+		}
+		err = dest.Interface().(json.Unmarshaler).UnmarshalJSON(data)
+	case ptrType.Implements(flagValueType):
+		err = dest.Interface().(flag.Value).Set(string(text))
+	default:
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal %q into %v: %w", text, destType, err)
+	}
+	if targetType.Kind() == reflect.Ptr {
+		return dest.Interface(), true, nil
+	}
+	return dest.Elem().Interface(), true, nil
+}
+
+// convertMap converts map keys and values to target map type
+func convertMap(targetType reflect.Type, value interface{}, adjustElement func(reflect.Type, interface{}) (interface{}, error)) (interface{}, error) {
+	source := reflect.ValueOf(value)
+	if source.IsNil() {
+		return reflect.Zero(targetType).Interface(), nil
+	}
+	result := reflect.MakeMapWithSize(targetType, source.Len())
+	iter := source.MapRange()
+	for iter.Next() {
+		key, err := adjustElement(targetType.Key(), iter.Key().Interface())
+		if err != nil {
+			return nil, fmt.Errorf("error converting map key %v: %w", iter.Key(), err)
+		}
+		item, err := adjustElement(targetType.Elem(), iter.Value().Interface())
+		if err != nil {
+			return nil, fmt.Errorf("error converting map value at key %v: %w", iter.Key(), err)
+		}
+		result.SetMapIndex(valueOf(targetType.Key(), key), valueOf(targetType.Elem(), item))
+	}
+	return result.Interface(), nil
+}
@@ -0,0 +1,39 @@
+package bindly
+
+import (
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+)
+
+type region string
+
+func TestConvertValue(t *testing.T) {
+	aContext := &BindingContext[struct{}]{}
+	var testCases = []struct {
+		description string
+		targetType  reflect.Type
+		value       interface{}
+		expect      interface{}
+		expectOk    bool
+		expectErr   bool
+	}{
+		{description: "map values", targetType: reflect.TypeOf(map[string]int{}), value: map[string]interface{}{"read": 10.0, "write": "5"}, expect: map[string]int{"read": 10, "write": 5}, expectOk: true},
+		{description: "map nil value", targetType: reflect.TypeOf(map[string]*int{}), value: map[string]interface{}{"read": nil}, expect: map[string]*int{"read": nil}, expectOk: true},
+		{description: "map keys", targetType: reflect.TypeOf(map[int]string{}), value: map[string]string{"1": "a"}, expect: map[int]string{1: "a"}, expectOk: true},
+		{description: "map value error", targetType: reflect.TypeOf(map[string]int{}), value: map[string]interface{}{"read": "many"}, expectErr: true},
+		{description: "named type", targetType: reflect.TypeOf(region("")), value: "us-west-2", expect: region("us-west-2"), expectOk: true},
+		{description: "named slice", targetType: reflect.TypeOf([]region{}), value: []string{"a"}, expect: nil},
+		{description: "different kinds", targetType: reflect.TypeOf(""), value: 65, expect: nil},
+	}
+	for _, testCase := range testCases {
+		actual, ok, err := convertValue(testCase.targetType, testCase.value, aContext.adjustElementValue)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expectOk, ok, testCase.description)
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}
@@ -0,0 +1,215 @@
+package bindly
+
+import (
+	"fmt"
+	"github.com/viant/bindly/xform/conv"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+type (
+	// decoder decodes map values into structs, matching keys to fields by tag name or case-insensitive field name
+	decoder struct {
+		tag    string
+		strict bool
+		fields sync.Map
+	}
+
+	// decodeFields represents struct fields indexed by key
+	decodeFields struct {
+		byName      map[string][]int
+		byLowerName map[string][]int
+	}
+)
+
+// isStructTarget returns true if target type is a struct or struct pointer
+func isStructTarget(targetType reflect.Type) bool {
+	if targetType.Kind() == reflect.Ptr {
+		targetType = targetType.Elem()
+	}
+	return targetType.Kind() == reflect.Struct && targetType != timeType
+}
+
+// decode converts value to target type, recursing into structs, slices and maps
+func (d *decoder) decode(targetType reflect.Type, value interface{}) (interface{}, error) {
+	if value == nil {
+		return reflect.Zero(targetType).Interface(), nil
+	}
+	valueType := reflect.TypeOf(value)
+	if valueType.AssignableTo(targetType) {
+		return value, nil
+	}
+	if valueType.Kind() == reflect.Ptr {
+		rValue := reflect.ValueOf(value)
+		if rValue.IsNil() {
+			return reflect.Zero(targetType).Interface(), nil
+		}
+		return d.decode(targetType, rValue.Elem().Interface())
+	}
+	if targetType.Kind() == reflect.Ptr {
+		decoded, err := d.decode(targetType.Elem(), value)
+		if err != nil {
+			return nil, err
+		}
+		ptrValue := reflect.New(targetType.Elem())
+		ptrValue.Elem().Set(valueOf(targetType.Elem(), decoded))
+		return ptrValue.Interface(), nil
+	}
+	switch {
+	case isStructTarget(targetType) && valueType.Kind() == reflect.Map:
+		return d.decodeStruct(targetType, value)
+	case targetType.Kind() == reflect.Slice && (valueType.Kind() == reflect.Slice || valueType.Kind() == reflect.Array):
+		return d.decodeSlice(targetType, value)
+	case conv.IsNumeric(targetType.Kind()) && (conv.IsNumeric(valueType.Kind()) || valueType.Kind() == reflect.String):
+		if targetType == durationType && valueType.Kind() == reflect.String {
+			if duration, err := time.ParseDuration(reflect.ValueOf(value).String()); err == nil {
+				return duration, nil
+			}
+		}
+		return conv.Number(value, targetType)
+	case targetType == timeType && conv.IsNumeric(valueType.Kind()):
+		seconds, err := conv.Number(value, reflect.TypeOf(int64(0)))
+		if err != nil {
+			return nil, err
+		}
+		return time.Unix(seconds.(int64), 0).UTC(), nil
+	case targetType.Kind() == reflect.Bool && valueType.Kind() == reflect.String:
+		return strconv.ParseBool(reflect.ValueOf(value).String())
+	}
+	if converted, ok, err := convertValue(targetType, value, d.decode); ok || err != nil {
+		return converted, err
+	}
+	switch valueType.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		if targetType.Kind() == reflect.String {
+			return reflect.ValueOf(fmt.Sprintf("%v", value)).Convert(targetType).Interface(), nil
+		}
+	}
+	return nil, fmt.Errorf("incompatible types: expected %v but got %v", targetType, valueType)
+}
+
+// decodeStruct sets struct fields from map entries
+func (d *decoder) decodeStruct(targetType reflect.Type, value interface{}) (interface{}, error) {
+	source := reflect.ValueOf(value)
+	if source.Type().Key().Kind() != reflect.String {
+		return nil, fmt.Errorf("incompatible types: expected %v but got %v", targetType, source.Type())
+	}
+	result := reflect.New(targetType).Elem()
+	if source.IsNil() {
+		return result.Interface(), nil
+	}
+	fields := d.structFields(targetType)
+	keys := make([]string, 0, source.Len())
+	for _, key := range source.MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+	var unknown []string
+	for _, key := range keys {
+		index, ok := fields.lookup(key)
+		if !ok {
+			unknown = append(unknown, key)
+			continue
+		}
+		field := result.FieldByIndex(index)
+		item := source.MapIndex(reflect.ValueOf(key).Convert(source.Type().Key())).Interface()
+		decoded, err := d.decode(field.Type(), item)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %v.%v: %w", targetType.Name(), key, err)
+		}
+		field.Set(valueOf(field.Type(), decoded))
+	}
+	if d.strict && len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown field(s) %v in %v", strings.Join(unknown, ", "), targetType)
+	}
+	return result.Interface(), nil
+}
+
+// decodeSlice converts slice elements to target slice element type
+func (d *decoder) decodeSlice(targetType reflect.Type, value interface{}) (interface{}, error) {
+	source := reflect.ValueOf(value)
+	if source.Kind() == reflect.Slice && source.IsNil() {
+		return reflect.Zero(targetType).Interface(), nil
+	}
+	result := reflect.MakeSlice(targetType, source.Len(), source.Len())
+	for i := 0; i < source.Len(); i++ {
+		decoded, err := d.decode(targetType.Elem(), source.Index(i).Interface())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode element at index %d: %w", i, err)
+		}
+		result.Index(i).Set(valueOf(targetType.Elem(), decoded))
+	}
+	return result.Interface(), nil
+}
+
+// valueOf returns reflect value of v, or zero value of t for nil
+func valueOf(t reflect.Type, v interface{}) reflect.Value {
+	if v == nil {
+		return reflect.Zero(t)
+	}
+	return reflect.ValueOf(v)
+}
+
+// structFields returns cached struct field index
+func (d *decoder) structFields(structType reflect.Type) *decodeFields {
+	if cached, ok := d.fields.Load(structType); ok {
+		return cached.(*decodeFields)
+	}
+	ret := &decodeFields{byName: map[string][]int{}, byLowerName: map[string][]int{}}
+	d.indexFields(ret, structType, nil)
+	d.fields.Store(structType, ret)
+	return ret
+}
+
+func (d *decoder) indexFields(fields *decodeFields, structType reflect.Type, parent []int) {
+	var embedded []reflect.StructField
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		index := append(append([]int{}, parent...), i)
+		name := field.Name
+		if d.tag != "" {
+			if tagValue, ok := field.Tag.Lookup(d.tag); ok {
+				if tagName := strings.Split(tagValue, ",")[0]; tagName == "-" {
+					continue
+				} else if tagName != "" {
+					name = tagName
+				}
+			}
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && name == field.Name {
+			embedded = append(embedded, field)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if _, ok := fields.byName[name]; !ok {
+			fields.byName[name] = index
+		}
+		if _, ok := fields.byLowerName[strings.ToLower(name)]; !ok {
+			fields.byLowerName[strings.ToLower(name)] = index
+		}
+	}
+	for _, field := range embedded { //outer fields shadow promoted ones
+		d.indexFields(fields, field.Type, append(append([]int{}, parent...), field.Index...))
+	}
+}
+
+// lookup returns field index for key, exact match takes precedence over case-insensitive one
+func (f *decodeFields) lookup(key string) ([]int, bool) {
+	if index, ok := f.byName[key]; ok {
+		return index, true
+	}
+	index, ok := f.byLowerName[strings.ToLower(key)]
+	return index, ok
+}
@@ -0,0 +1,126 @@
+package bindly
+
+import (
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type (
+	decodePool struct {
+		Size    int
+		Timeout time.Duration
+	}
+
+	decodeBase struct {
+		Name string
+	}
+
+	decodeConfig struct {
+		decodeBase
+		Host     string         `json:"hostname"`
+		Port     uint16         `json:"port"`
+		Debug    bool           `json:"debug"`
+		Started  time.Time      `json:"started"`
+		Pool     *decodePool    `json:"pool"`
+		Replicas []decodePool   `json:"replicas"`
+		Limits   map[string]int `json:"limits"`
+		Level    region         `json:"level"`
+		Ignored  string         `json:"-"`
+		Any      interface{}    `json:"any"`
+	}
+)
+
+func TestDecoder_Decode(t *testing.T) {
+	started := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	var testCases = []struct {
+		description string
+		decoder     *decoder
+		targetType  reflect.Type
+		value       interface{}
+		expect      interface{}
+		expectErr   bool
+	}{
+		{
+			description: "case insensitive field names",
+			decoder:     &decoder{},
+			targetType:  reflect.TypeOf(decodePool{}),
+			value:       map[string]interface{}{"size": 10.0, "TIMEOUT": "5s"},
+			expect:      decodePool{Size: 10, Timeout: 5 * time.Second},
+		},
+		{
+			description: "tag names with nested values",
+			decoder:     &decoder{tag: "json"},
+			targetType:  reflect.TypeOf(&decodeConfig{}),
+			value: map[string]interface{}{
+				"name":     "primary",
+				"hostname": "db",
+				"port":     "5432",
+				"debug":    "true",
+				"started":  "2024-01-02T03:04:05Z",
+				"pool":     map[string]interface{}{"size": 4, "timeout": 1000},
+				"replicas": []interface{}{map[string]interface{}{"size": 2}},
+				"limits":   map[string]interface{}{"read": 10.0},
+				"level":    "info",
+				"Ignored":  "x",
+				"any":      nil,
+			},
+			expect: &decodeConfig{
+				decodeBase: decodeBase{Name: "primary"},
+				Host:       "db",
+				Port:       5432,
+				Debug:      true,
+				Started:    started,
+				Pool:       &decodePool{Size: 4, Timeout: 1000},
+				Replicas:   []decodePool{{Size: 2}},
+				Limits:     map[string]int{"read": 10},
+				Level:      "info",
+			},
+		},
+		{
+			description: "epoch time",
+			decoder:     &decoder{},
+			targetType:  reflect.TypeOf(decodeConfig{}),
+			value:       map[string]interface{}{"started": started.Unix()},
+			expect:      decodeConfig{Started: started},
+		},
+		{
+			description: "conversion error",
+			decoder:     &decoder{},
+			targetType:  reflect.TypeOf(decodePool{}),
+			value:       map[string]interface{}{"size": "many"},
+			expectErr:   true,
+		},
+		{
+			description: "unknown key ignored",
+			decoder:     &decoder{},
+			targetType:  reflect.TypeOf(decodePool{}),
+			value:       map[string]interface{}{"size": 1, "extra": true},
+			expect:      decodePool{Size: 1},
+		},
+		{
+			description: "unknown key strict",
+			decoder:     &decoder{strict: true},
+			targetType:  reflect.TypeOf(decodePool{}),
+			value:       map[string]interface{}{"size": 1, "extra": true},
+			expectErr:   true,
+		},
+		{
+			description: "nested unknown key strict",
+			decoder:     &decoder{tag: "json", strict: true},
+			targetType:  reflect.TypeOf(decodeConfig{}),
+			value:       map[string]interface{}{"pool": map[string]interface{}{"max": 1}},
+			expectErr:   true,
+		},
+	}
+	for _, testCase := range testCases {
+		actual, err := testCase.decoder.decode(testCase.targetType, testCase.value)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}
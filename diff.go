@@ -0,0 +1,95 @@
+package bindly
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Redacted replaces secret binding values in Diff result
+const Redacted = "******"
+
+type (
+	// Change represents bound field whose resolved value differs from the target value
+	Change struct {
+		Path     string
+		Location string
+		Old      interface{}
+		New      interface{}
+		Secret   bool
+	}
+
+	// Changes represents changes sorted by field path
+	Changes []*Change
+)
+
+// String returns change description, i.e. Port (setting:port): 8080 -> 9090
+func (c *Change) String() string {
+	return fmt.Sprintf("%v (%v): %v -> %v", c.Path, c.Location, c.Old, c.New)
+}
+
+// Has returns true if any of supplied field paths changed
+func (c Changes) Has(paths ...string) bool {
+	for _, change := range c {
+		for _, aPath := range paths {
+			if change.Path == aPath {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Paths returns changed field paths
+func (c Changes) Paths() []string {
+	var result = make([]string, 0, len(c))
+	for _, change := range c {
+		result = append(result, change.Path)
+	}
+	return result
+}
+
+// Diff resolves every binding bypassing value cache and compares it with the target field value,
+// values of bindings tagged with secret are redacted, the target is not modified
+func (c *BindingContext[T]) Diff(ctx context.Context, target *T) (Changes, error) {
+	bindingType, err := c.getBindingType(ctx, reflect.TypeOf(target))
+	if err != nil {
+		return nil, err
+	}
+	targetState := bindingType.Type.WithValue(target)
+	var result Changes
+	for _, group := range bindingType.Bindings {
+		for _, binding := range group {
+			if binding.lazy {
+				continue
+			}
+			value, ok, err := c.resolveValue(ctx, binding)
+			if err != nil {
+				return nil, err
+			}
+			if value, ok = binding.fieldValue(value, ok); !ok {
+				continue
+			}
+			aPath := binding.selector.Path()
+			current := reflect.Zero(binding.selector.Type()).Interface()
+			if binding.isReachable(targetState) {
+				if current, err = targetState.Value(aPath); err != nil {
+					return nil, err
+				}
+			}
+			if reflect.DeepEqual(current, value) {
+				continue
+			}
+			change := &Change{Path: aPath, Location: binding.location.String(), Old: current, New: value, Secret: binding.secret}
+			if binding.secret {
+				change.Old, change.New = Redacted, Redacted
+			}
+			result = append(result, change)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package bindly
+
+import (
+	"context"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/structology"
+	"reflect"
+)
+
+// Extract writes target field values back to their source locations, it is the inverse of Inject.
+// Transformers implementing xform.Reverser convert field value back to source representation,
+// nil pointer, slice or map values are written as nil without reverse conversion, so the source is cleared,
+// collection bindings and bindings whose locator does not implement locator.Writer are skipped
+func (c *BindingContext[T]) Extract(ctx context.Context, target *T) error {
+	bindingType, err := c.getBindingType(ctx, reflect.TypeOf(target))
+	if err != nil {
+		return err
+	}
+	targetState := bindingType.Type.WithValue(target)
+	for _, group := range bindingType.Bindings {
+		for _, binding := range group {
+			if err := c.extractValue(ctx, binding, targetState); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *BindingContext[T]) extractValue(ctx context.Context, binding *Binding, srcState *structology.State) error {
+	aLocator := binding.provider.Locate(c.state)
+	if aLocator == nil {
+		return fmt.Errorf("failed to locate: %v", binding.location)
+	}
+	writer, ok := aLocator.(locator.Writer)
+	if !ok {
+		return nil
+	}
+	if binding.all || binding.lazy || !binding.isReachable(srcState) {
+		return nil
+	}
+	value, err := srcState.Value(binding.selector.Path())
+	if err != nil {
+		return err
+	}
+	if holder, ok := value.(optional); ok && binding.optional {
+		if value, ok = holder.value(); !ok {
+			return nil
+		}
+	}
+	if isNil(value) { //cleared field is written as zero value
+		value = nil
+	} else if reverser, ok := binding.transformer.(xform.Reverser); ok {
+		if value, err = reverser.Reverse(ctx, c, value); err != nil {
+			return fmt.Errorf("failed to reverse value: %v, %w", binding.location, err)
+		}
+	}
+	if err = writer.SetValue(ctx, binding.location.In, value); err != nil {
+		return fmt.Errorf("failed to write value: %v, %w", binding.location, err)
+	}
+	if c.valueCache != nil {
+		c.valueCache.Delete(binding.selector.Path())
+	}
+	return nil
+}
+
+// isNil returns true for nil value or nil pointer, map, slice or interface
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	rValue := reflect.ValueOf(value)
+	switch rValue.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func:
+		return rValue.IsNil()
+	}
+	return false
+}
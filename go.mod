@@ -7,6 +7,7 @@ require (
 	github.com/viant/afs v1.25.1
 	github.com/viant/structology v0.6.2-0.20250313135129-f2630b17b35c
 	github.com/viant/tagly v0.2.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -20,5 +21,4 @@ require (
 	github.com/viant/xunsafe v0.9.2 // indirect
 	golang.org/x/crypto v0.3.0 // indirect
 	golang.org/x/sys v0.2.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
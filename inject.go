@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/viant/bindly/locator"
 	"github.com/viant/bindly/state"
+	"github.com/viant/bindly/xform/conv"
 	"github.com/viant/structology"
 	"reflect"
 	"sync"
@@ -12,6 +13,38 @@ import (
 
 // Inject binds dependencies to the target
 func (c *BindingContext[T]) Inject(ctx context.Context, target *T) error {
+	return c.inject(ctx, target)
+}
+
+// InjectAny binds dependencies to target whose type is known only at runtime,
+// target is a struct pointer or reflect.Value holding a struct pointer or an addressable struct
+func (b *Injector) InjectAny(ctx context.Context, state interface{}, target interface{}) error {
+	target, err := injectTarget(target)
+	if err != nil {
+		return err
+	}
+	return WithState[any](b, state).inject(ctx, target)
+}
+
+// injectTarget returns struct pointer for supplied target
+func injectTarget(target interface{}) (interface{}, error) {
+	if value, ok := target.(reflect.Value); ok {
+		if value.Kind() != reflect.Ptr && value.CanAddr() {
+			value = value.Addr()
+		}
+		if !value.IsValid() || !value.CanInterface() {
+			return nil, fmt.Errorf("invalid target: %v", value)
+		}
+		target = value.Interface()
+	}
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("invalid target: expected non nil struct pointer but had %T", target)
+	}
+	return target, nil
+}
+
+func (c *BindingContext[T]) inject(ctx context.Context, target interface{}) error {
 	targetType := reflect.TypeOf(target)
 	bindingType, err := c.getBindingType(ctx, targetType)
 	if err != nil {
@@ -29,12 +62,20 @@ func (c *BindingContext[T]) Inject(ctx context.Context, target *T) error {
 }
 
 func (c *BindingContext[T]) setDestinationValue(ctx context.Context, binding *Binding, destState *structology.State) error {
+	if binding.lazy {
+		if err := binding.ensureAncestors(destState, c.injector.allocUnexported); err != nil {
+			return err
+		}
+		return destState.SetValue(binding.selector.Path(), c.lazyValue(ctx, binding))
+	}
 	value, ok, err := c.sourceValue(ctx, binding)
 	if err != nil {
 		return err
 	}
-	if ok {
-
+	if value, ok = binding.fieldValue(value, ok); ok {
+		if err := binding.ensureAncestors(destState, c.injector.allocUnexported); err != nil {
+			return err
+		}
 		if err := destState.SetValue(binding.selector.Path(), value); err != nil {
 			return err
 		}
@@ -71,11 +112,40 @@ func (c *BindingContext[T]) sourceValue(ctx context.Context, binding *Binding) (
 		locker.Lock()
 		defer locker.Unlock()
 	}
+	value, ok, err := c.resolveValue(ctx, binding)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	/*TODO
+		- add option for traversing resolved dependency for its own binding
+		- add option for creating dependency struct on demand  (with or without singlton option)
+	*/
+
+	if isCacheable {
+		c.valueCache.Put(aPath, value)
+	}
+	return value, true, nil
+}
+
+// resolveValue locates, transforms and adjusts binding value bypassing value cache
+func (c *BindingContext[T]) resolveValue(ctx context.Context, binding *Binding) (interface{}, bool, error) {
 	aLocator := binding.provider.Locate(c.state)
 	if aLocator == nil {
 		return nil, false, fmt.Errorf("failed to locate: %v", binding.location)
 	}
-	value, ok, err := c.value(ctx, binding.location, aLocator)
+	var value interface{}
+	var ok bool
+	var err error
+	matcher, isMatcher := aLocator.(locator.Matcher)
+	switch {
+	case binding.all:
+		value, ok, err = c.collectValue(ctx, binding, aLocator)
+	case isMatcher:
+		value, ok, err = matcher.Match(ctx, binding.location.In, binding.valueType())
+	default:
+		value, ok, err = c.value(ctx, binding.location, aLocator)
+	}
 	if err != nil {
 		return nil, false, fmt.Errorf("failed to locate: %v, %w", binding.location, err)
 	}
@@ -92,11 +162,6 @@ func (c *BindingContext[T]) sourceValue(ctx context.Context, binding *Binding) (
 		return nil, false, nil
 	}
 
-	value, err = c.adjustValue(binding.selector, value)
-	if err != nil {
-		return nil, false, fmt.Errorf("failed to adjust value: %v, %w", binding.location, err)
-	}
-
 	if binding.transformer != nil {
 		transformed, err := binding.transformer.Transform(ctx, c, value)
 		if err != nil {
@@ -105,37 +170,33 @@ func (c *BindingContext[T]) sourceValue(ctx context.Context, binding *Binding) (
 		value = transformed
 	}
 
-	/*TODO
-		- add option for traversing resolved dependency for its own binding
-		- add option for creating dependency struct on demand  (with or without singlton option)
-	*/
-
-	if isCacheable && ok {
-		c.valueCache.Put(aPath, value)
+	value, err = c.adjustValue(binding.valueType(), value)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to adjust value: %v, %w", binding.location, err)
 	}
-	return value, ok, nil
+	return value, true, nil
 }
 
 func (c *BindingContext[T]) getBindingType(ctx context.Context, targetType reflect.Type) (*BindingType, error) {
-	bindingType, ok := c.injector.bindingCache.Get(targetType)
+	key := BindingKey{Type: targetType, Prefix: c.prefix}
+	bindingType, ok := c.injector.bindingCache.Get(key)
 	if !ok {
 		var err error
 		sType := structology.NewStateType(targetType)
-		if bindingType, err = c.injector.buildBindings(ctx, sType); err != nil {
+		if bindingType, err = c.injector.buildBindings(ctx, sType, c.prefix); err != nil {
 			return nil, err
 		}
-		c.injector.bindingCache.Put(targetType, bindingType)
+		c.injector.bindingCache.Put(key, bindingType)
 	}
 	return bindingType, nil
 }
 
-// adjustValue ensures type compatibility between the selector and value
-func (c *BindingContext[T]) adjustValue(selector *structology.Selector, value interface{}) (interface{}, error) {
+// adjustValue ensures type compatibility between the selector type and value
+func (c *BindingContext[T]) adjustValue(selectorType reflect.Type, value interface{}) (interface{}, error) {
 	if value == nil {
 		return nil, nil
 	}
 
-	selectorType := selector.Type()
 	valueType := reflect.TypeOf(value)
 
 	// If types are already compatible, return as is
@@ -143,11 +204,31 @@ func (c *BindingContext[T]) adjustValue(selector *structology.Selector, value in
 		return value, nil
 	}
 
+	// Handle map to struct decoding, i.e. map[string]interface{} into Config or *Config
+	if isStructTarget(selectorType) && valueType.Kind() == reflect.Map {
+		return c.injector.decoder.decode(selectorType, value)
+	}
+
 	// Handle special case: pointer vs. non-pointer
 	if selectorType.Kind() == reflect.Ptr && valueType.Kind() != reflect.Ptr {
 		// Need to convert non-pointer value to pointer
 		if !valueType.AssignableTo(selectorType.Elem()) {
-			return nil, fmt.Errorf("incompatible types: selector expects %v but got %v", selectorType, valueType)
+			if conv.IsNumeric(selectorType.Elem().Kind()) && conv.IsNumeric(valueType.Kind()) {
+				converted, err := conv.Number(value, selectorType.Elem())
+				if err != nil {
+					return nil, err
+				}
+				value, valueType = converted, selectorType.Elem()
+			} else {
+				converted, ok, err := convertValue(selectorType.Elem(), value, c.adjustElementValue)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					return nil, fmt.Errorf("incompatible types: selector expects %v but got %v", selectorType, valueType)
+				}
+				value, valueType = converted, selectorType.Elem()
+			}
 		}
 		valueReflect := reflect.ValueOf(value)
 		ptrValue := reflect.New(valueType)
@@ -168,11 +249,21 @@ func (c *BindingContext[T]) adjustValue(selector *structology.Selector, value in
 		return valueReflect.Elem().Interface(), nil
 	}
 
+	// Handle numeric conversions, i.e. float64 decoded from JSON into int field
+	if conv.IsNumeric(selectorType.Kind()) && conv.IsNumeric(valueType.Kind()) {
+		return conv.Number(value, selectorType)
+	}
+
 	// Handle slice conversions
 	if selectorType.Kind() == reflect.Slice && valueType.Kind() == reflect.Slice {
 		return c.adjustSliceValue(selectorType, value)
 	}
 
+	// Handle unmarshalers, map conversions and named types, i.e. string into LogLevel
+	if converted, ok, err := convertValue(selectorType, value, c.adjustElementValue); ok || err != nil {
+		return converted, err
+	}
+
 	// For any other incompatible types
 	return nil, fmt.Errorf("incompatible types: selector expects %v but got %v", selectorType, valueType)
 }
@@ -216,6 +307,10 @@ func (c *BindingContext[T]) adjustElementValue(targetType reflect.Type, value in
 		return value, nil
 	}
 
+	if isStructTarget(targetType) && valueType.Kind() == reflect.Map {
+		return c.injector.decoder.decode(targetType, value)
+	}
+
 	// Handle pointer vs. non-pointer
 	if targetType.Kind() == reflect.Ptr && valueType.Kind() != reflect.Ptr {
 		if !valueType.AssignableTo(targetType.Elem()) {
@@ -236,57 +331,19 @@ func (c *BindingContext[T]) adjustElementValue(targetType reflect.Type, value in
 		return valueReflect.Elem().Interface(), nil
 	}
 
-	// Try basic numeric conversions
-	if isNumericType(targetType) && isNumericType(valueType) {
-		return convertNumeric(targetType, valueReflect)
+	// Try basic numeric conversions, numeric text is parsed, i.e. split output into []int
+	if conv.IsNumeric(targetType.Kind()) && (conv.IsNumeric(valueType.Kind()) || valueType.Kind() == reflect.String) {
+		return conv.Number(value, targetType)
+	}
+
+	if converted, ok, err := convertValue(targetType, value, c.adjustElementValue); ok || err != nil {
+		return converted, err
 	}
 
 	// Handle string conversion if possible
 	if targetType.Kind() == reflect.String {
-		return fmt.Sprintf("%v", value), nil
+		return reflect.ValueOf(fmt.Sprintf("%v", value)).Convert(targetType).Interface(), nil
 	}
 
 	return nil, fmt.Errorf("incompatible element types: target expects %v but got %v", targetType, valueType)
 }
-
-// isNumericType checks if a type is numeric (int, float, etc.)
-func isNumericType(t reflect.Type) bool {
-	switch t.Kind() {
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-		reflect.Float32, reflect.Float64:
-		return true
-	}
-	return false
-}
-
-// convertNumeric converts between numeric types
-func convertNumeric(targetType reflect.Type, value reflect.Value) (interface{}, error) {
-	var floatVal float64
-
-	// Extract float value regardless of original type
-	switch value.Kind() {
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		floatVal = float64(value.Int())
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		floatVal = float64(value.Uint())
-	case reflect.Float32, reflect.Float64:
-		floatVal = value.Float()
-	default:
-		return nil, fmt.Errorf("not a numeric type: %v", value.Type())
-	}
-
-	// Convert to target type
-	switch targetType.Kind() {
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return int64(floatVal), nil
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return uint64(floatVal), nil
-	case reflect.Float32:
-		return float32(floatVal), nil
-	case reflect.Float64:
-		return floatVal, nil
-	default:
-		return nil, fmt.Errorf("target is not a numeric type: %v", targetType)
-	}
-}
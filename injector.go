@@ -4,7 +4,13 @@ import (
 	"github.com/viant/bindly/locator"
 	"github.com/viant/bindly/types"
 	"github.com/viant/bindly/xform"
+	"github.com/viant/bindly/xform/codec"
 	"github.com/viant/bindly/xform/conv"
+	"github.com/viant/bindly/xform/expr"
+	"github.com/viant/bindly/xform/network"
+	"github.com/viant/bindly/xform/text"
+	"github.com/viant/bindly/xform/tmpl"
+	"github.com/viant/bindly/xform/validate"
 )
 
 // Injector represents dependency injector
@@ -18,6 +24,11 @@ type Injector struct {
 	bindingCache    *BindingCache
 	structTypeCache *StructTypeCache
 	embedder        types.Embedder
+	decoder         *decoder
+	fieldConfigs    *FieldConfigs
+	manifests       *FieldConfigs
+	nested          bool
+	allocUnexported bool
 }
 
 // NewInjector creates injector
@@ -30,6 +41,9 @@ func NewInjector(options ...InjectorOption) *Injector {
 		interfaceKind:   "interface",
 		bindingCache:    NewBindingCache(),
 		structTypeCache: NewStructTypeCache(),
+		decoder:         &decoder{},
+		fieldConfigs:    NewFieldConfigs(),
+		manifests:       NewFieldConfigs(),
 	}
 
 	for _, option := range options {
@@ -37,6 +51,12 @@ func NewInjector(options ...InjectorOption) *Injector {
 	}
 	if ret.transformers != nil {
 		conv.Init(ret.transformers)
+		codec.Init(ret.transformers)
+		tmpl.Init(ret.transformers)
+		expr.Init(ret.transformers)
+		validate.Init(ret.transformers)
+		text.Init(ret.transformers)
+		network.Init(ret.transformers)
 	}
 	if len(ret.providers) > 0 {
 		for _, provider := range ret.providers {
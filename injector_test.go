@@ -2,12 +2,21 @@ package bindly_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/stretchr/testify/assert"
+	"github.com/viant/afs"
+	"github.com/viant/afs/file"
 	"github.com/viant/bindly"
 	"github.com/viant/bindly/locator/buildin"
+	"github.com/viant/bindly/types"
+	"github.com/viant/bindly/xform"
 	"github.com/viant/structology"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 )
 
 type ICounter interface {
@@ -87,3 +96,880 @@ func TestInjector_Inject(t *testing.T) {
 	assert.Nil(t, err)
 
 }
+
+func TestInjector_Inject_CachedValueIsolation(t *testing.T) {
+	type Settings struct {
+		Name string
+		Port int
+	}
+	type DependencySetup struct {
+		Settings *Settings
+	}
+	type Service struct {
+		Name string `bind:"kind=setting,in=Name,cacheable"`
+		Port int    `bind:"kind=direct,in=Port,cacheable"`
+	}
+	settings := &Settings{Name: "app", Port: 8080}
+	setup := &DependencySetup{Settings: settings}
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1), buildin.Direct("direct", settings, 1)))
+	bindingCtx := bindly.WithState[Service](injector, setup)
+	assert.Nil(t, bindingCtx.Inject(context.Background(), &Service{}))
+
+	settings.Name, settings.Port = "changed", 9090
+	service := &Service{}
+	assert.Nil(t, bindingCtx.Inject(context.Background(), service))
+	assert.Equal(t, &Service{Name: "app", Port: 8080}, service, "cached values do not follow source fields")
+}
+
+func TestInjector_Inject_Transform(t *testing.T) {
+	type Settings struct {
+		Ports   string
+		Env     string
+		Ratio   float64
+		Timeout string
+	}
+	type DependencySetup struct {
+		Settings *Settings
+	}
+	type Server struct {
+		Ports   []int         `bind:"kind=setting,in=Ports" xform:"split"`
+		Env     string        `bind:"kind=setting,in=Env" xform:"trim|lower|enum,values=dev;prod"`
+		Ratio   int           `bind:"kind=setting,in=Ratio"`
+		Timeout time.Duration `bind:"kind=setting,in=Timeout" xform:"duration|range,max=1m"`
+	}
+
+	var testCases = []struct {
+		description string
+		settings    *Settings
+		expect      *Server
+		expectErr   bool
+	}{
+		{
+			description: "transformed values",
+			settings:    &Settings{Ports: "80, 443", Env: " PROD ", Ratio: 3, Timeout: "30s"},
+			expect:      &Server{Ports: []int{80, 443}, Env: "prod", Ratio: 3, Timeout: 30 * time.Second},
+		},
+		{
+			description: "invalid enum",
+			settings:    &Settings{Ports: "80", Env: "qa", Timeout: "1s"},
+			expectErr:   true,
+		},
+		{
+			description: "out of range",
+			settings:    &Settings{Ports: "80", Env: "dev", Timeout: "2m"},
+			expectErr:   true,
+		},
+		{
+			description: "fractional float to int",
+			settings:    &Settings{Ports: "80", Env: "dev", Ratio: 1.5, Timeout: "1s"},
+			expectErr:   true,
+		},
+	}
+
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1)))
+	for _, testCase := range testCases {
+		server := &Server{}
+		err := bindly.WithState[Server](injector, &DependencySetup{Settings: testCase.settings}).Inject(context.Background(), server)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, server, testCase.description)
+	}
+}
+
+type LogLevel int
+
+func (l *LogLevel) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "debug":
+		*l = 0
+	case "info":
+		*l = 1
+	case "error":
+		*l = 2
+	default:
+		return fmt.Errorf("unknown log level: %s", text)
+	}
+	return nil
+}
+
+type Region string
+
+type Hosts []string
+
+func (h *Hosts) String() string {
+	return strings.Join(*h, ",")
+}
+
+func (h *Hosts) Set(value string) error {
+	*h = strings.Split(value, ",")
+	return nil
+}
+
+type Version struct {
+	Major, Minor int
+}
+
+func (v *Version) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	_, err := fmt.Sscanf(text, "%d.%d", &v.Major, &v.Minor)
+	return err
+}
+
+func TestInjector_Inject_Convert(t *testing.T) {
+	type Settings struct {
+		Level   string
+		Region  string
+		Hosts   string
+		Version []byte
+	}
+	type DependencySetup struct {
+		Settings *Settings
+	}
+	type Service struct {
+		Level    LogLevel  `bind:"kind=setting,in=Level"`
+		LevelPtr *LogLevel `bind:"kind=setting,in=Level"`
+		Region   Region    `bind:"kind=setting,in=Region"`
+		Hosts    Hosts     `bind:"kind=setting,in=Hosts"`
+		Version  Version   `bind:"kind=setting,in=Version"`
+	}
+	level := LogLevel(2)
+
+	var testCases = []struct {
+		description string
+		settings    *Settings
+		expect      *Service
+		expectErr   bool
+	}{
+		{
+			description: "converted values",
+			settings:    &Settings{Level: "ERROR", Region: "us-east-1", Hosts: "a,b", Version: []byte(`"1.2"`)},
+			expect:      &Service{Level: 2, LevelPtr: &level, Region: "us-east-1", Hosts: Hosts{"a", "b"}, Version: Version{Major: 1, Minor: 2}},
+		},
+		{
+			description: "unmarshal error",
+			settings:    &Settings{Level: "trace", Version: []byte("1.2")},
+			expectErr:   true,
+		},
+		{
+			description: "json unmarshal error",
+			settings:    &Settings{Level: "info", Version: []byte("1.2")},
+			expectErr:   true,
+		},
+	}
+
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1)))
+	for _, testCase := range testCases {
+		service := &Service{}
+		err := bindly.WithState[Service](injector, &DependencySetup{Settings: testCase.settings}).Inject(context.Background(), service)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, service, testCase.description)
+	}
+
+	type Release struct {
+		Version Version `bind:"kind=setting,in=Level"`
+	}
+	release := &Release{}
+	err := bindly.WithState[Release](injector, &DependencySetup{Settings: &Settings{Level: "1.2"}}).Inject(context.Background(), release)
+	assert.Nil(t, err, "numeric looking string is decoded as JSON string")
+	assert.Equal(t, &Release{Version: Version{Major: 1, Minor: 2}}, release)
+}
+
+func TestBindingContext_Extract(t *testing.T) {
+	type Settings struct {
+		Timeout string
+		Port    int
+		Hosts   string
+		Secret  []byte
+	}
+	type DependencySetup struct {
+		Settings *Settings
+	}
+	type Service struct {
+		Timeout time.Duration `bind:"kind=setting,in=Timeout" xform:"duration"`
+		Port    int           `bind:"kind=setting,in=Port"`
+		Hosts   []string      `bind:"kind=setting,in=Hosts" xform:"split|len,min=1"`
+		Secret  string        `bind:"kind=setting,in=Secret" xform:"unbase64"`
+	}
+
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1)))
+	settings := &Settings{Timeout: "30s", Port: 8080, Hosts: "a,b", Secret: []byte("c2VjcmV0")}
+	setup := &DependencySetup{Settings: settings}
+	service := &Service{}
+	err := bindly.WithState[Service](injector, setup).Inject(context.Background(), service)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, &Service{Timeout: 30 * time.Second, Port: 8080, Hosts: []string{"a", "b"}, Secret: "secret"}, service)
+
+	service.Timeout = 90 * time.Second
+	service.Port = 9090
+	service.Hosts = append(service.Hosts, "c")
+	service.Secret = "changed"
+	err = bindly.WithState[Service](injector, setup).Extract(context.Background(), service)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, &Settings{Timeout: "1m30s", Port: 9090, Hosts: "a,b,c", Secret: []byte("Y2hhbmdlZA==")}, settings)
+
+	roundTrip := &Service{}
+	err = bindly.WithState[Service](injector, setup).Inject(context.Background(), roundTrip)
+	assert.Nil(t, err)
+	assert.Equal(t, service, roundTrip)
+
+	roundTrip.Hosts = nil
+	err = bindly.WithState[Service](injector, setup).Extract(context.Background(), roundTrip)
+	assert.Nil(t, err)
+	assert.Equal(t, "", settings.Hosts, "cleared field is written as zero value")
+}
+
+func TestBindingContext_Diff(t *testing.T) {
+	type Settings struct {
+		Port     int
+		Timeout  string
+		Password string
+		Name     string
+	}
+	type DependencySetup struct {
+		Settings *Settings
+	}
+	type Config struct {
+		Port     int           `bind:"kind=setting,in=Port"`
+		Timeout  time.Duration `bind:"kind=setting,in=Timeout" xform:"duration"`
+		Password string        `bind:"kind=setting,in=Password,secret"`
+		Name     string        `bind:"kind=setting,in=Name,cacheable"`
+	}
+
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1)))
+	settings := &Settings{Port: 8080, Timeout: "5s", Password: "abc", Name: "app"}
+	bindingCtx := bindly.WithState[Config](injector, &DependencySetup{Settings: settings})
+	config := &Config{}
+	if !assert.Nil(t, bindingCtx.Inject(context.Background(), config)) {
+		return
+	}
+	changes, err := bindingCtx.Diff(context.Background(), config)
+	assert.Nil(t, err)
+	assert.Empty(t, changes)
+
+	settings.Port = 9090
+	settings.Password = "xyz"
+	settings.Name = "renamed"
+	changes, err = bindingCtx.Diff(context.Background(), config)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Equal(t, bindly.Changes{
+		{Path: "Name", Location: "setting:Name", Old: "app", New: "renamed"},
+		{Path: "Password", Location: "setting:Password", Old: bindly.Redacted, New: bindly.Redacted, Secret: true},
+		{Path: "Port", Location: "setting:Port", Old: 8080, New: 9090},
+	}, changes)
+	assert.True(t, changes.Has("Port"))
+	assert.False(t, changes.Has("Timeout"))
+	assert.Equal(t, "Port (setting:Port): 8080 -> 9090", changes[2].String())
+	assert.Equal(t, 8080, config.Port)
+}
+
+func TestConfigure(t *testing.T) {
+	type Settings struct {
+		Timeout string
+		Hosts   string
+		Env     string
+	}
+	type DependencySetup struct {
+		Settings *Settings
+	}
+	type Server struct {
+		Timeout time.Duration `bind:"kind=setting,in=Timeout"`
+		Hosts   []string      `bind:"kind=setting,in=Hosts" xform:"split"`
+		Env     string        `bind:"kind=setting,in=Env" xform:"lower"`
+	}
+	setup := &DependencySetup{Settings: &Settings{Timeout: "5s", Hosts: "a;b", Env: " Prod "}}
+
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1)))
+	env := bindly.Field("Env").Transform(xform.Config{Name: "trim"}, xform.Config{Name: "upper"})
+	err := bindly.Configure[Server](injector,
+		bindly.Field("Timeout").Transform(xform.Config{Name: "duration"}),
+		bindly.Field("Hosts").Transform(xform.Config{Name: "split", Parameters: []string{"sep=;"}}),
+		env,
+	)
+	if !assert.Nil(t, err) {
+		return
+	}
+	env.Transform(xform.Config{Name: "lower"}) //configured field is copied
+	server := &Server{}
+	err = bindly.WithState[Server](injector, setup).Inject(context.Background(), server)
+	assert.Nil(t, err)
+	assert.Equal(t, &Server{Timeout: 5 * time.Second, Hosts: []string{"a", "b"}, Env: "PROD"}, server)
+
+	err = bindly.Configure[Server](injector, bindly.Field("Unknown").Transform(xform.Config{Name: "trim"}))
+	assert.NotNil(t, err)
+
+	fileInjector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1)))
+	configURL := "mem://localhost/bindly/server.yaml"
+	fs := afs.New()
+	err = fs.Upload(context.Background(), configURL, file.DefaultFileOsMode, strings.NewReader(`
+fields:
+  - path: Timeout
+    transform:
+      - name: duration
+  - path: Hosts
+    transform:
+      - name: split
+        parameters: ["sep=;"]
+`))
+	if !assert.Nil(t, err) {
+		return
+	}
+	err = bindly.ConfigureFromURL[Server](context.Background(), fileInjector, configURL)
+	if !assert.Nil(t, err) {
+		return
+	}
+	server = &Server{}
+	err = bindly.WithState[Server](fileInjector, setup).Inject(context.Background(), server)
+	assert.Nil(t, err)
+	assert.Equal(t, &Server{Timeout: 5 * time.Second, Hosts: []string{"a", "b"}, Env: " prod "}, server)
+}
+
+// markedSettingsHas records located settings
+type markedSettingsHas struct {
+	Port    bool
+	Timeout bool
+	Name    bool
+	Hosts   bool
+	Region  bool
+}
+
+// markedSettings represents settings with set markers
+type markedSettings struct {
+	Port    int
+	Timeout string
+	Name    string
+	Hosts   string
+	Region  string
+	Has     *markedSettingsHas `setMarker:"true"`
+}
+
+type markedSetup struct {
+	Settings *markedSettings
+}
+
+// newMarkedSetup returns setup with non zero and listed settings marked as set
+func newMarkedSetup(settings markedSettings, set ...string) *markedSetup {
+	settings.Has = &markedSettingsHas{}
+	value, has := reflect.ValueOf(settings), reflect.ValueOf(settings.Has).Elem()
+	for i := 0; i < has.NumField(); i++ {
+		name := has.Type().Field(i).Name
+		has.Field(i).SetBool(!value.FieldByName(name).IsZero() || slices.Contains(set, name))
+	}
+	return &markedSetup{Settings: &settings}
+}
+
+func TestFor(t *testing.T) {
+	type Service struct { //untagged, i.e. generated type
+		Port    int
+		Timeout time.Duration
+		Name    string
+		Region  string
+	}
+
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1)))
+	builder := bindly.For[Service](injector)
+	builder.Field("Port").From("setting", "Port").Required().Cacheable().
+		Field("Timeout").From("setting", "Timeout").Transform(xform.Config{Name: "duration"}).
+		Field("Region").From("setting", "Region").Default("us-east-1")
+	if !assert.Nil(t, builder.Err()) {
+		return
+	}
+
+	service := &Service{}
+	err := bindly.WithState[Service](injector, newMarkedSetup(markedSettings{Port: 8080, Timeout: "3s", Name: "app"})).Inject(context.Background(), service)
+	assert.Nil(t, err)
+	assert.Equal(t, &Service{Port: 8080, Timeout: 3 * time.Second, Region: "us-east-1"}, service)
+
+	err = bindly.WithState[Service](injector, newMarkedSetup(markedSettings{Timeout: "3s"})).Inject(context.Background(), &Service{})
+	assert.NotNil(t, err, "required")
+
+	assert.NotNil(t, bindly.For[Service](injector).Field("Missing").From("setting", "Port").Err())
+}
+
+func TestInjector_LoadManifest(t *testing.T) {
+	type Service struct {
+		Port    int
+		Timeout time.Duration
+		Hosts   []string `xform:"split"`
+		Region  string
+		Name    string `bind:"kind=setting,in=Timeout"` //tag wins over manifest
+	}
+	types.RegisterType(types.NewType(reflect.TypeOf(Service{}), types.WithName("ManifestService")))
+
+	manifestURL := "mem://localhost/bindly/manifest.yaml"
+	err := afs.New().Upload(context.Background(), manifestURL, file.DefaultFileOsMode, strings.NewReader(`
+types:
+  - type: ManifestService
+    fields:
+      - path: Port
+        kind: setting
+        in: Port
+        required: true
+        cacheable: true
+      - path: Timeout
+        kind: setting
+        in: Timeout
+        xform: duration
+      - path: Hosts
+        kind: setting
+        in: Hosts
+        xform: join
+      - path: Region
+        kind: setting
+        in: Region
+        default: us-east-1
+      - path: Name
+        kind: setting
+        in: Port
+`))
+	if !assert.Nil(t, err) {
+		return
+	}
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1)))
+	if !assert.Nil(t, injector.LoadManifest(context.Background(), manifestURL)) {
+		return
+	}
+	service := &Service{}
+	err = bindly.WithState[Service](injector, newMarkedSetup(markedSettings{Port: 8080, Timeout: "3s", Hosts: "a,b"})).Inject(context.Background(), service)
+	assert.Nil(t, err)
+	assert.Equal(t, &Service{Port: 8080, Timeout: 3 * time.Second, Hosts: []string{"a", "b"}, Region: "us-east-1", Name: "3s"}, service)
+
+	err = bindly.WithState[Service](injector, newMarkedSetup(markedSettings{})).Inject(context.Background(), &Service{})
+	assert.NotNil(t, err, "required")
+
+	assert.NotNil(t, injector.AddManifest(&bindly.Manifest{Types: []*bindly.TypeManifest{{Type: "UnknownManifestType"}}}))
+}
+
+func TestWithNestedBindings(t *testing.T) {
+	type Settings struct {
+		Port     int
+		CertFile string
+		Name     string
+	}
+	type DependencySetup struct {
+		Settings *Settings
+	}
+	type TLS struct {
+		CertFile string `bind:"kind=setting,in=CertFile"`
+	}
+	type Server struct {
+		Port int `bind:"kind=setting,in=Port"`
+		TLS  *TLS
+	}
+	type Meta struct {
+		Name string `bind:"kind=setting,in=Name"`
+	}
+	type Config struct {
+		Meta
+		Server Server
+	}
+	setup := &DependencySetup{Settings: &Settings{Port: 8443, CertFile: "/etc/cert.pem", Name: "app"}}
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1)), bindly.WithNestedBindings())
+	bindingCtx := bindly.WithState[Config](injector, setup)
+	config := &Config{}
+	err := bindingCtx.Inject(context.Background(), config)
+	assert.Nil(t, err)
+	assert.Equal(t, &Config{Meta: Meta{Name: "app"}, Server: Server{Port: 8443, TLS: &TLS{CertFile: "/etc/cert.pem"}}}, config)
+
+	changes, err := bindingCtx.Diff(context.Background(), &Config{Server: Server{Port: 8443}})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Meta.Name", "Server.TLS.CertFile"}, changes.Paths())
+
+	config.Server.TLS.CertFile = "/etc/tls.pem"
+	assert.Nil(t, bindingCtx.Extract(context.Background(), config))
+	assert.Equal(t, "/etc/tls.pem", setup.Settings.CertFile)
+
+	config = &Config{}
+	err = bindly.WithState[Config](bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1))), setup).Inject(context.Background(), config)
+	assert.Nil(t, err)
+	assert.Equal(t, &Config{}, config, "nested bindings are opt-in")
+}
+
+func TestInjector_Inject_Prefix(t *testing.T) {
+	type DBSettings struct {
+		Host string
+		Port int
+	}
+	type DBs struct {
+		Primary *DBSettings
+		Replica *DBSettings
+	}
+	type DependencySetup struct {
+		DB *DBs
+	}
+	type DBConfig struct {
+		Host string `bind:"kind=setting,in=Host"`
+		Port int    `bind:"kind=setting,in=Port"`
+	}
+	type Config struct {
+		Primary DBConfig  `bind:"prefix=Primary"`
+		Replica *DBConfig `bind:"prefix=Replica"`
+	}
+	setup := &DependencySetup{DB: &DBs{Primary: &DBSettings{Host: "primary", Port: 5432}, Replica: &DBSettings{Host: "replica", Port: 5433}}}
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "DB", 1)))
+
+	config := &Config{}
+	err := bindly.WithState[Config](injector, setup).Inject(context.Background(), config)
+	assert.Nil(t, err)
+	assert.Equal(t, &Config{Primary: DBConfig{Host: "primary", Port: 5432}, Replica: &DBConfig{Host: "replica", Port: 5433}}, config)
+
+	replica := &DBConfig{}
+	err = bindly.WithState[DBConfig](injector, setup, bindly.WithPrefix[DBConfig]("Replica")).Inject(context.Background(), replica)
+	assert.Nil(t, err)
+	assert.Equal(t, &DBConfig{Host: "replica", Port: 5433}, replica)
+
+	primary := &DBConfig{}
+	err = bindly.WithState[DBConfig](injector, setup, bindly.WithPrefix[DBConfig]("Primary")).Inject(context.Background(), primary)
+	assert.Nil(t, err)
+	assert.Equal(t, &DBConfig{Host: "primary", Port: 5432}, primary)
+
+	type Invalid struct {
+		Name string `bind:"prefix=Primary"`
+	}
+	assert.NotNil(t, bindly.WithState[Invalid](injector, setup).Inject(context.Background(), &Invalid{}))
+}
+
+type Handler interface {
+	Handle() string
+}
+
+type namedHandler string
+
+func (h namedHandler) Handle() string {
+	return string(h)
+}
+
+func TestInjector_Inject_All(t *testing.T) {
+	type Plugins struct {
+		Users   Handler
+		Orders  Handler
+		Health  Handler
+		Counter ICounter
+		Name    string
+	}
+	type DependencySetup struct {
+		Plugins *Plugins
+	}
+	type Service struct {
+		Handlers []Handler          `bind:"kind=plugin,all"`
+		Counters []ICounter         `bind:"kind=plugin,all"`
+		Registry map[string]Handler `bind:"kind=plugin,all"`
+	}
+	setup := &DependencySetup{Plugins: &Plugins{Users: namedHandler("users"), Orders: namedHandler("orders"), Health: namedHandler("health"), Name: "app"}}
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("plugin", "Plugins", 1)))
+	service := &Service{}
+	err := bindly.WithState[Service](injector, setup).Inject(context.Background(), service)
+	assert.Nil(t, err)
+	assert.Equal(t, []Handler{namedHandler("health"), namedHandler("orders"), namedHandler("users")}, service.Handlers)
+	assert.Nil(t, service.Counters)
+	assert.Equal(t, map[string]Handler{"Health": namedHandler("health"), "Orders": namedHandler("orders"), "Users": namedHandler("users")}, service.Registry)
+
+	service.Handlers = nil
+	assert.Nil(t, bindly.WithState[Service](injector, setup).Extract(context.Background(), service))
+	assert.Equal(t, namedHandler("users"), setup.Plugins.Users)
+
+	type Invalid struct {
+		Handler Handler `bind:"kind=plugin,all"`
+	}
+	assert.NotNil(t, bindly.WithState[Invalid](injector, setup).Inject(context.Background(), &Invalid{}))
+}
+
+type Closer interface {
+	Close() error
+}
+
+type closingHandler string
+
+func (h closingHandler) Handle() string {
+	return string(h)
+}
+
+func (h closingHandler) Close() error {
+	return nil
+}
+
+func TestInjector_Inject_AllInterfaces(t *testing.T) {
+	type Service struct {
+		Handlers []Handler          `bind:"kind=interface,all"`
+		Registry map[string]Handler `bind:"kind=interface,all"`
+	}
+	interfaces := buildin.Interfaces("interface", 1)
+	assert.Nil(t, buildin.Register[Handler](interfaces, closingHandler("a")))
+	assert.Nil(t, buildin.Register[Closer](interfaces, closingHandler("a")))
+	assert.Nil(t, buildin.Register[Handler](interfaces, namedHandler("b"), buildin.Named("b")))
+	injector := bindly.NewInjector(bindly.WithProviders(interfaces))
+	service := &Service{}
+	err := bindly.WithState[Service](injector, &struct{}{}).Inject(context.Background(), service)
+	assert.Nil(t, err)
+	assert.Equal(t, []Handler{namedHandler("b"), closingHandler("a")}, service.Handlers)
+	assert.Equal(t, map[string]Handler{"b": namedHandler("b"), "bindly_test.closingHandler": closingHandler("a")}, service.Registry)
+
+	type Registry struct {
+		Handlers map[string]Handler `bind:"kind=interface,all"`
+	}
+	duplicates := buildin.Interfaces("interface", 1)
+	assert.Nil(t, buildin.Register[Handler](duplicates, closingHandler("c")))
+	assert.Nil(t, buildin.Register[Closer](duplicates, closingHandler("d")))
+	err = bindly.WithState[Registry](bindly.NewInjector(bindly.WithProviders(duplicates)), &struct{}{}).Inject(context.Background(), &Registry{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "duplicate name")
+	}
+}
+
+type DB interface {
+	Name() string
+}
+
+type namedDB string
+
+func (d namedDB) Name() string {
+	return string(d)
+}
+
+func TestInjector_Inject_Qualified(t *testing.T) {
+	type Repository struct {
+		Reader DB `bind:"kind=interface,name=readonly"`
+		Writer DB `bind:"kind=interface,name=readwrite"`
+		Any    DB
+	}
+	interfaces := buildin.Interfaces("interface", 1)
+	assert.Nil(t, buildin.Register[DB](interfaces, namedDB("ro"), buildin.Named("readonly")))
+	assert.Nil(t, buildin.Register[DB](interfaces, namedDB("rw"), buildin.Named("readwrite")))
+	assert.NotNil(t, buildin.Register[DB](interfaces, namedDB("rw"), buildin.Named("readwrite")), "duplicate")
+
+	injector := bindly.NewInjector(bindly.WithProviders(interfaces))
+	err := bindly.WithState[Repository](injector, &struct{}{}).Inject(context.Background(), &Repository{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "ambiguous")
+	}
+
+	assert.Nil(t, buildin.Register[DB](interfaces, namedDB("default"), buildin.Named("default"), buildin.Primary()))
+	repository := &Repository{}
+	err = bindly.WithState[Repository](injector, &struct{}{}).Inject(context.Background(), repository)
+	assert.Nil(t, err)
+	assert.Equal(t, &Repository{Reader: namedDB("ro"), Writer: namedDB("rw"), Any: namedDB("default")}, repository)
+
+	type Settings struct {
+		Host string
+	}
+	type DependencySetup struct {
+		Settings *Settings
+	}
+	type Pooled struct {
+		Reader DB `bind:"kind=plugin,name=readonly"`
+	}
+	type Invalid struct {
+		Host string `bind:"kind=setting,in=Host,name=primary"`
+	}
+	pool := buildin.Pool("plugin", 1)
+	assert.Nil(t, pool.Add(namedDB("ro"), buildin.Named("readonly")))
+	injector = bindly.NewInjector(bindly.WithProviders(pool, buildin.Struct("setting", "Settings", 1)))
+	pooled := &Pooled{}
+	err = bindly.WithState[Pooled](injector, &DependencySetup{}).Inject(context.Background(), pooled)
+	assert.Nil(t, err)
+	assert.Equal(t, &Pooled{Reader: namedDB("ro")}, pooled)
+
+	err = bindly.WithState[Invalid](injector, &DependencySetup{Settings: &Settings{Host: "localhost"}}).Inject(context.Background(), &Invalid{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "name qualifier is not supported by setting kind")
+	}
+}
+
+func TestInjector_Inject_Pool(t *testing.T) {
+	type Service struct {
+		Counter ICounter
+		DB      DB `bind:"kind=interface,name=readonly"`
+	}
+	pool := buildin.Pool("interface", 1, &Counter{})
+	assert.Nil(t, pool.Add(namedDB("ro"), buildin.Named("readonly")))
+	assert.Nil(t, pool.Add(namedDB("rw"), buildin.Named("readwrite")))
+	injector := bindly.NewInjector(bindly.WithProviders(pool))
+	service := &Service{}
+	err := bindly.WithState[Service](injector, &struct{}{}).Inject(context.Background(), service)
+	assert.Nil(t, err)
+	assert.Equal(t, &Service{Counter: &Counter{}, DB: namedDB("ro")}, service)
+
+	type Ambiguous struct {
+		DB DB
+	}
+	err = bindly.WithState[Ambiguous](injector, &struct{}{}).Inject(context.Background(), &Ambiguous{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "ambiguous")
+	}
+
+	type Missing struct {
+		Handler Handler
+	}
+	assert.NotNil(t, bindly.WithState[Missing](injector, &struct{}{}).Inject(context.Background(), &Missing{}))
+}
+
+func TestInjector_Inject_Lazy(t *testing.T) {
+	type Settings struct {
+		Port int
+		Name string
+	}
+	type DependencySetup struct {
+		Settings *Settings
+	}
+	type Service struct {
+		Port    func() (int, error)   `bind:"kind=setting,in=Port" xform:"scoped"`
+		Name    bindly.Lazy[string]   `bind:"kind=setting,in=Name,cacheable"`
+		Missing bindly.Lazy[string]   `bind:"kind=setting,in=Missing,required"`
+		Counter bindly.Lazy[ICounter] `bind:"kind=interface"`
+	}
+	setup := &DependencySetup{Settings: &Settings{Port: 8080, Name: "app"}}
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1), buildin.Pool("interface", 1, &Counter{})))
+	type scopeKey struct{}
+	injector.TransformerRegistry().Register("scoped", xform.Func("scoped", func(ctx context.Context, port int, params xform.Parameters) (int, error) {
+		if ctx.Value(scopeKey{}) == nil {
+			return 0, fmt.Errorf("missing scope")
+		}
+		return port, ctx.Err()
+	}))
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), scopeKey{}, "request"))
+	service := &Service{}
+	err := bindly.WithState[Service](injector, setup).Inject(ctx, service)
+	cancel()
+	if !assert.Nil(t, err, "required value is resolved on call") {
+		return
+	}
+	setup.Settings = &Settings{Port: 9090, Name: "app"}
+	port, err := service.Port()
+	assert.Nil(t, err)
+	assert.Equal(t, 9090, port)
+
+	name, err := service.Name.Get()
+	assert.Nil(t, err)
+	assert.Equal(t, "app", name)
+	setup.Settings = &Settings{Port: 9090, Name: "changed"}
+	name, _ = service.Name.Get()
+	assert.Equal(t, "app", name, "cacheable binding resolves once")
+
+	counter, err := service.Counter.Get()
+	assert.Nil(t, err)
+	assert.Equal(t, &Counter{}, counter)
+
+	_, err = service.Missing.Get()
+	assert.NotNil(t, err)
+
+	type Callback struct {
+		Open func() (ICounter, error)
+	}
+	open := func() (ICounter, error) { return &Counter{}, nil }
+	callback := &Callback{Open: open}
+	injector = bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1)))
+	err = bindly.WithState[Callback](injector, setup).Inject(context.Background(), callback)
+	assert.Nil(t, err, "untagged callback is not bound")
+	counter, err = callback.Open()
+	assert.Nil(t, err)
+	assert.Equal(t, &Counter{}, counter)
+}
+
+func TestInjector_Inject_Optional(t *testing.T) {
+	type PatchHas struct {
+		Port bool
+		Name bool
+	}
+	type Patch struct {
+		Port bindly.Optional[int]    `bind:"kind=setting,in=Port"`
+		Name bindly.Optional[string] `bind:"kind=setting,in=Name"`
+	}
+	type Update struct {
+		Port int       `bind:"kind=setting,in=Port"`
+		Name string    `bind:"kind=setting,in=Name"`
+		Has  *PatchHas `setMarker:"true"`
+	}
+	setup := newMarkedSetup(markedSettings{Port: 0}, "Port")
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1)))
+
+	patch := &Patch{}
+	err := bindly.WithState[Patch](injector, setup).Inject(context.Background(), patch)
+	assert.Nil(t, err)
+	assert.Equal(t, &Patch{Port: bindly.Optional[int]{Valid: true, Source: "setting:Port"}}, patch)
+	_, ok := patch.Name.Get()
+	assert.False(t, ok)
+	assert.Equal(t, "default", patch.Name.OrElse("default"))
+
+	update := &Update{}
+	err = bindly.WithState[Update](injector, setup).Inject(context.Background(), update)
+	assert.Nil(t, err)
+	assert.Equal(t, &Update{Has: &PatchHas{Port: true}}, update)
+}
+
+func TestInjector_InjectAny(t *testing.T) {
+	type Settings struct {
+		Port int
+		Name string
+	}
+	type DependencySetup struct {
+		Settings *Settings
+	}
+	type Internal struct {
+		Port int `bind:"kind=setting,in=Port"`
+	}
+	type Service struct {
+		Name     string `bind:"kind=setting,in=Name"`
+		port     int    `bind:"kind=setting,in=Port"`
+		counter  ICounter
+		internal *Internal
+	}
+	setup := &DependencySetup{Settings: &Settings{Port: 8080, Name: "app"}}
+	providers := bindly.WithProviders(buildin.Struct("setting", "Settings", 1), buildin.Pool("interface", 1, &Counter{}))
+
+	service := &Service{}
+	err := bindly.NewInjector(providers).InjectAny(context.Background(), setup, service)
+	assert.Nil(t, err)
+	assert.Equal(t, &Service{Name: "app", port: 8080, counter: &Counter{}}, service)
+
+	err = bindly.NewInjector(providers, bindly.WithNestedBindings()).InjectAny(context.Background(), setup, &Service{})
+	if assert.NotNil(t, err, "unexported pointer allocation is opt-in") {
+		assert.Contains(t, err.Error(), "WithUnexportedAllocation")
+	}
+
+	injector := bindly.NewInjector(providers, bindly.WithUnexportedAllocation(), bindly.WithNestedBindings())
+	var target interface{} = &Service{}
+	err = injector.InjectAny(context.Background(), setup, target)
+	assert.Nil(t, err)
+	assert.Equal(t, &Service{Name: "app", port: 8080, counter: &Counter{}, internal: &Internal{Port: 8080}}, target)
+
+	services := make([]Service, 1)
+	err = injector.InjectAny(context.Background(), setup, reflect.ValueOf(services).Index(0))
+	assert.Nil(t, err)
+	assert.Equal(t, "app", services[0].Name)
+
+	assert.NotNil(t, injector.InjectAny(context.Background(), setup, Service{}))
+	assert.NotNil(t, injector.InjectAny(context.Background(), setup, reflect.ValueOf(Service{})))
+}
+
+func TestInjector_Inject_Unexported(t *testing.T) {
+	type Settings struct {
+		Port int
+	}
+	type DependencySetup struct {
+		Settings *Settings
+	}
+	type Service struct {
+		port    int `bind:"kind=setting,in=Port"`
+		counter ICounter
+	}
+	injector := bindly.NewInjector(bindly.WithProviders(buildin.Struct("setting", "Settings", 1), buildin.Pool("interface", 1, &Counter{})))
+	service := &Service{}
+	err := bindly.WithState[Service](injector, &DependencySetup{Settings: &Settings{Port: 8080}}).Inject(context.Background(), service)
+	assert.Nil(t, err)
+	assert.Equal(t, &Service{port: 8080, counter: &Counter{}}, service)
+}
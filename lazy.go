@@ -0,0 +1,45 @@
+package bindly
+
+import (
+	"context"
+	"reflect"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// Lazy represents deferred dependency accessor, binding is resolved when accessor is called.
+// Fields typed func() (T, error) are injected the same way, cacheable bindings resolve only once per value cache.
+// Accessor keeps Inject context values but not its cancellation or deadline, so it can be called after the context is done
+type Lazy[T any] func() (T, error)
+
+// Get resolves value, nil accessor returns zero value
+func (l Lazy[T]) Get() (T, error) {
+	if l == nil {
+		var zero T
+		return zero, nil
+	}
+	return l()
+}
+
+// isLazyType returns true for func() (T, error) types, including Lazy[T]
+func isLazyType(t reflect.Type) bool {
+	return t.Kind() == reflect.Func && t.NumIn() == 0 && t.NumOut() == 2 && t.Out(1) == errorType
+}
+
+// lazyValue creates binding accessor of selector type resolving value with sourceValue, Inject context is detached from cancellation
+func (c *BindingContext[T]) lazyValue(ctx context.Context, binding *Binding) interface{} {
+	ctx = context.WithoutCancel(ctx)
+	fnType := binding.selector.Type()
+	fn := reflect.MakeFunc(fnType, func(args []reflect.Value) []reflect.Value {
+		result := reflect.New(fnType.Out(0)).Elem()
+		errValue := reflect.New(errorType).Elem()
+		value, ok, err := c.sourceValue(ctx, binding)
+		if err != nil {
+			errValue.Set(reflect.ValueOf(err))
+		} else if ok && value != nil {
+			result.Set(reflect.ValueOf(value))
+		}
+		return []reflect.Value{result, errValue}
+	})
+	return fn.Interface()
+}
@@ -26,7 +26,12 @@ func (l *directLocator) Value(ctx context.Context, name string) (interface{}, bo
 		// The field doesn't exist
 		return nil, false, nil
 	}
-	return value, true, nil
+	return detach(value), true, nil
+}
+
+// Entries returns struct field values
+func (l *directLocator) Entries(ctx context.Context) ([]*locator.Entry, error) {
+	return structEntries(reflect.ValueOf(l.state.State()), ""), nil
 }
 
 func (l *directLocator) Kind() string {
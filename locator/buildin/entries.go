@@ -0,0 +1,73 @@
+package buildin
+
+import (
+	"github.com/viant/bindly/locator"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// structEntries returns non nil exported struct field values at dotted path ordered by field name, set markers are skipped
+func structEntries(value reflect.Value, aPath string) []*locator.Entry {
+	if aPath != "" {
+		for _, name := range strings.Split(aPath, ".") {
+			if value = indirect(value); !value.IsValid() || value.Kind() != reflect.Struct {
+				return nil
+			}
+			value = value.FieldByName(name)
+		}
+	}
+	if value = indirect(value); !value.IsValid() || value.Kind() != reflect.Struct {
+		return nil
+	}
+	var result []*locator.Entry
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if _, ok := field.Tag.Lookup("setMarker"); ok {
+			continue
+		}
+		fieldValue := value.Field(i)
+		if isNilValue(fieldValue) {
+			continue
+		}
+		result = append(result, &locator.Entry{Name: field.Name, Value: fieldValue.Interface()})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// detach copies value read through structology, its interface data refers to source field memory
+// and would otherwise change with the source, i.e. after being cached
+func detach(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	rValue := reflect.ValueOf(value)
+	ret := reflect.New(rValue.Type()).Elem()
+	ret.Set(rValue)
+	return ret.Interface()
+}
+
+// indirect dereferences pointers and interfaces, invalid value is returned for nil
+func indirect(value reflect.Value) reflect.Value {
+	for value.IsValid() && (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+func isNilValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func, reflect.Chan:
+		return value.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,201 @@
+package buildin
+
+import (
+	"context"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/structology"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type (
+	// InterfaceRegistry represents interface implementations registry, implementations are keyed by interface type and optional name
+	InterfaceRegistry struct {
+		kind     string
+		priority int
+		mux      sync.RWMutex
+		entries  map[string][]*interfaceEntry
+	}
+
+	interfaceEntry struct {
+		name    string
+		primary bool
+		value   interface{}
+	}
+
+	// InterfaceOption represents interface registration option
+	InterfaceOption func(e *interfaceEntry)
+
+	interfaceLocator struct {
+		registry *InterfaceRegistry
+	}
+)
+
+// Named returns option qualifying implementation with name, i.e. bind:"kind=interface,name=readonly"
+func Named(name string) InterfaceOption {
+	return func(e *interfaceEntry) {
+		e.name = name
+	}
+}
+
+// Primary returns option marking implementation as default for unqualified lookup
+func Primary() InterfaceOption {
+	return func(e *interfaceEntry) {
+		e.primary = true
+	}
+}
+
+// Register registers value implementing I
+func Register[I any](registry *InterfaceRegistry, value I, options ...InterfaceOption) error {
+	var iface *I
+	return registry.Register(structology.InterfaceTypeOf(iface), value, options...)
+}
+
+// Register registers value implementing interface type
+func (r *InterfaceRegistry) Register(interfaceType reflect.Type, value interface{}, options ...InterfaceOption) error {
+	if interfaceType.Kind() != reflect.Interface {
+		return fmt.Errorf("expected interface type but had %v", interfaceType)
+	}
+	if value == nil || !reflect.TypeOf(value).Implements(interfaceType) {
+		return fmt.Errorf("%T does not implement %v", value, interfaceType)
+	}
+	entry := &interfaceEntry{value: value}
+	for _, option := range options {
+		option(entry)
+	}
+	if strings.Contains(entry.name, locator.QualifierSeparator) {
+		return fmt.Errorf("invalid %v name: %v", interfaceType, entry.name)
+	}
+	key := interfaceType.String()
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	for _, prev := range r.entries[key] {
+		if prev.name == entry.name {
+			return fmt.Errorf("%v is already registered", locator.QualifiedName(key, entry.name))
+		}
+	}
+	r.entries[key] = append(r.entries[key], entry)
+	return nil
+}
+
+// lookup returns implementation for interface type name, unqualified lookup uses the only or primary implementation
+func (r *InterfaceRegistry) lookup(name string) (interface{}, bool, error) {
+	typeName, qualifier := locator.SplitQualifiedName(name)
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	entries := r.entries[typeName]
+	if qualifier != "" {
+		for _, entry := range entries {
+			if entry.name == qualifier {
+				return entry.value, true, nil
+			}
+		}
+		return nil, false, nil
+	}
+	return selectEntry(typeName, entries)
+}
+
+// selectEntry returns the only or primary entry value, multiple candidates without single primary are ambiguous
+func selectEntry(typeName string, entries []*interfaceEntry) (interface{}, bool, error) {
+	switch len(entries) {
+	case 0:
+		return nil, false, nil
+	case 1:
+		return entries[0].value, true, nil
+	}
+	var primary []*interfaceEntry
+	for _, entry := range entries {
+		if entry.primary {
+			primary = append(primary, entry)
+		}
+	}
+	if len(primary) == 1 {
+		return primary[0].value, true, nil
+	}
+	if len(primary) > 1 {
+		entries = primary
+	}
+	return nil, false, fmt.Errorf("ambiguous %v binding, candidates: %v, use name qualifier or mark one implementation as primary", typeName, entryNames(entries))
+}
+
+func entryNames(entries []*interfaceEntry) string {
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.name
+		if names[i] == "" {
+			names[i] = fmt.Sprintf("%T", entry.value)
+		}
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func (l *interfaceLocator) Value(ctx context.Context, name string) (interface{}, bool, error) {
+	return l.registry.lookup(name)
+}
+
+// Entries returns registered implementations ordered by name, instance registered under more than one interface
+// is listed once, unnamed instances use concrete type name
+func (l *interfaceLocator) Entries(ctx context.Context) ([]*locator.Entry, error) {
+	l.registry.mux.RLock()
+	defer l.registry.mux.RUnlock()
+	typeNames := make([]string, 0, len(l.registry.entries))
+	for typeName := range l.registry.entries {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+	var result []*locator.Entry
+	for _, typeName := range typeNames {
+		for _, entry := range l.registry.entries[typeName] {
+			if hasInstance(result, entry.value) {
+				continue
+			}
+			name := entry.name
+			if name == "" {
+				name = reflect.TypeOf(entry.value).String()
+			}
+			result = append(result, &locator.Entry{Name: name, Value: entry.value})
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// hasInstance returns true if entries hold the same instance, values of not comparable types are never the same
+func hasInstance(entries []*locator.Entry, value interface{}) bool {
+	if !reflect.TypeOf(value).Comparable() {
+		return false
+	}
+	for _, entry := range entries {
+		if reflect.TypeOf(entry.Value) == reflect.TypeOf(value) && entry.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *interfaceLocator) Kind() string {
+	return l.registry.kind
+}
+
+func (r *InterfaceRegistry) Locate(state *structology.State) locator.Locator {
+	return &interfaceLocator{registry: r}
+}
+
+func (r *InterfaceRegistry) Kind() string {
+	return r.kind
+}
+
+func (r *InterfaceRegistry) Priority() int {
+	return r.priority
+}
+
+// Interfaces creates interface implementations registry provider
+func Interfaces(kind string, priority int) *InterfaceRegistry {
+	return &InterfaceRegistry{kind: kind, priority: priority, entries: map[string][]*interfaceEntry{}}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/viant/bindly/locator"
 	"github.com/viant/structology"
+	"sort"
 )
 
 type (
@@ -33,6 +34,46 @@ func (l *mapLocator) Value(ctx context.Context, name string) (interface{}, bool,
 	return result, ok, nil
 }
 
+// SetValue sets map entry value, nil map is allocated
+func (l *mapLocator) SetValue(ctx context.Context, name string, value interface{}) error {
+	current, err := l.state.Value(l.rootSelector)
+	if err != nil {
+		return err
+	}
+	iFaces, ok := current.(map[string]interface{})
+	if !ok && current != nil {
+		return fmt.Errorf("expected map[string]interface{} but had %T", current)
+	}
+	if iFaces == nil {
+		iFaces = map[string]interface{}{}
+		if err = l.state.SetValue(l.rootSelector, iFaces); err != nil {
+			return err
+		}
+	}
+	iFaces[name] = value
+	return nil
+}
+
+// Entries returns map entries ordered by key
+func (l *mapLocator) Entries(ctx context.Context) ([]*locator.Entry, error) {
+	value, err := l.state.Value(l.rootSelector)
+	if err != nil {
+		return nil, err
+	}
+	iFaces, ok := value.(map[string]interface{})
+	if !ok && value != nil {
+		return nil, fmt.Errorf("expected map[string]interface{} but had %T", value)
+	}
+	result := make([]*locator.Entry, 0, len(iFaces))
+	for name, item := range iFaces {
+		result = append(result, &locator.Entry{Name: name, Value: item})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
 func (p *mapLocator) Kind() string {
 	return p.kind
 }
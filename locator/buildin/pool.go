@@ -0,0 +1,142 @@
+package buildin
+
+import (
+	"context"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/structology"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type (
+	// InstancePool represents instances matched to destination type by assignability
+	InstancePool struct {
+		kind     string
+		priority int
+		mux      sync.RWMutex
+		entries  []*interfaceEntry
+	}
+
+	poolLocator struct {
+		pool *InstancePool
+	}
+)
+
+// Add adds instance to the pool, Named and Primary options qualify instance
+func (p *InstancePool) Add(value interface{}, options ...InterfaceOption) error {
+	if value == nil {
+		return fmt.Errorf("instance was nil")
+	}
+	entry := &interfaceEntry{value: value}
+	for _, option := range options {
+		option(entry)
+	}
+	if strings.Contains(entry.name, locator.QualifierSeparator) {
+		return fmt.Errorf("invalid instance name: %v", entry.name)
+	}
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if entry.name != "" {
+		for _, prev := range p.entries {
+			if prev.name == entry.name {
+				return fmt.Errorf("instance %v is already added", entry.name)
+			}
+		}
+	}
+	p.entries = append(p.entries, entry)
+	return nil
+}
+
+// match returns instance assignable to destination type, zero or multiple unqualified matches are reported
+func (p *InstancePool) match(name string, destType reflect.Type) (interface{}, bool, error) {
+	_, qualifier := locator.SplitQualifiedName(name)
+	p.mux.RLock()
+	defer p.mux.RUnlock()
+	var candidates []*interfaceEntry
+	for _, entry := range p.entries {
+		if !reflect.TypeOf(entry.value).AssignableTo(destType) {
+			continue
+		}
+		if qualifier != "" && entry.name != qualifier {
+			continue
+		}
+		candidates = append(candidates, entry)
+	}
+	if len(candidates) == 0 {
+		return nil, false, fmt.Errorf("failed to match %v: no instance found", locator.QualifiedName(destType.String(), qualifier))
+	}
+	return selectEntry(destType.String(), candidates)
+}
+
+// Match returns the single instance assignable to destination type, or instance with matching name qualifier
+func (p *InstancePool) Match(ctx context.Context, name string, destType reflect.Type) (interface{}, bool, error) {
+	return p.match(name, destType)
+}
+
+func (l *poolLocator) Value(ctx context.Context, name string) (interface{}, bool, error) {
+	typeName, qualifier := locator.SplitQualifiedName(name)
+	if qualifier == "" {
+		return nil, false, fmt.Errorf("failed to locate %v: pool requires destination type or name qualifier", typeName)
+	}
+	l.pool.mux.RLock()
+	defer l.pool.mux.RUnlock()
+	for _, entry := range l.pool.entries {
+		if entry.name == qualifier {
+			return entry.value, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// Match returns the single instance assignable to destination type, or instance with matching name qualifier
+func (l *poolLocator) Match(ctx context.Context, name string, destType reflect.Type) (interface{}, bool, error) {
+	return l.pool.match(name, destType)
+}
+
+// Entries returns pool instances ordered by name, unnamed instances use type name
+func (l *poolLocator) Entries(ctx context.Context) ([]*locator.Entry, error) {
+	l.pool.mux.RLock()
+	defer l.pool.mux.RUnlock()
+	result := make([]*locator.Entry, 0, len(l.pool.entries))
+	for _, entry := range l.pool.entries {
+		name := entry.name
+		if name == "" {
+			name = reflect.TypeOf(entry.value).String()
+		}
+		result = append(result, &locator.Entry{Name: name, Value: entry.value})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+func (l *poolLocator) Kind() string {
+	return l.pool.kind
+}
+
+func (p *InstancePool) Locate(state *structology.State) locator.Locator {
+	return &poolLocator{pool: p}
+}
+
+func (p *InstancePool) Kind() string {
+	return p.kind
+}
+
+func (p *InstancePool) Priority() int {
+	return p.priority
+}
+
+// Pool creates instance pool provider, interface fields are bound to the single instance implementing the interface
+func Pool(kind string, priority int, instances ...interface{}) *InstancePool {
+	ret := &InstancePool{kind: kind, priority: priority}
+	for _, instance := range instances {
+		if instance != nil {
+			ret.entries = append(ret.entries, &interfaceEntry{value: instance})
+		}
+	}
+	return ret
+}
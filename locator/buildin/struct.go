@@ -2,8 +2,11 @@ package buildin
 
 import (
 	"context"
+	"fmt"
 	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform/conv"
 	"github.com/viant/structology"
+	"reflect"
 )
 
 type (
@@ -20,22 +23,70 @@ type (
 )
 
 func (l *structLocator) Value(ctx context.Context, name string) (interface{}, bool, error) {
-	aPath := l.rootSelector + "." + name
-	if name == "" {
-		aPath = l.rootSelector
-	} else if l.rootSelector == "" {
-		aPath = name
-	}
-	selector, err := l.state.Selector(aPath)
+	selector, err := l.state.Selector(l.path(name))
 	if err != nil {
 		return nil, false, err
 	}
 	ptr := l.state.Pointer()
-	value := selector.Value(ptr)
+	value := detach(selector.Value(ptr))
 	hasValue := selector.Has(ptr)
 	return value, hasValue, nil
 }
 
+// SetValue sets struct field value, numeric and convertible values are converted to field type
+func (l *structLocator) SetValue(ctx context.Context, name string, value interface{}) error {
+	aPath := l.path(name)
+	selector, err := l.state.Selector(aPath)
+	if err != nil {
+		return err
+	}
+	if value, err = fieldValue(selector.Type(), value); err != nil {
+		return fmt.Errorf("failed to set %v: %w", aPath, err)
+	}
+	return l.state.SetValue(aPath, value)
+}
+
+// fieldValue converts value to field type
+func fieldValue(fieldType reflect.Type, value interface{}) (interface{}, error) {
+	if value == nil {
+		return reflect.Zero(fieldType).Interface(), nil
+	}
+	valueType := reflect.TypeOf(value)
+	switch {
+	case valueType == fieldType:
+		return value, nil
+	case conv.IsNumeric(fieldType.Kind()) && conv.IsNumeric(valueType.Kind()):
+		return conv.Number(value, fieldType)
+	case valueType.ConvertibleTo(fieldType) && !conv.IsNumeric(valueType.Kind()):
+		return reflect.ValueOf(value).Convert(fieldType).Interface(), nil
+	case fieldType.Kind() == reflect.Ptr && valueType.ConvertibleTo(fieldType.Elem()):
+		elem, err := fieldValue(fieldType.Elem(), value)
+		if err != nil {
+			return nil, err
+		}
+		ptr := reflect.New(fieldType.Elem())
+		ptr.Elem().Set(reflect.ValueOf(elem))
+		return ptr.Interface(), nil
+	case fieldType.Kind() == reflect.Interface && valueType.Implements(fieldType):
+		return value, nil
+	}
+	return nil, fmt.Errorf("incompatible types: field expects %v but got %v", fieldType, valueType)
+}
+
+// Entries returns root selector struct field values
+func (l *structLocator) Entries(ctx context.Context) ([]*locator.Entry, error) {
+	return structEntries(reflect.ValueOf(l.state.State()), l.rootSelector), nil
+}
+
+func (l *structLocator) path(name string) string {
+	if name == "" {
+		return l.rootSelector
+	} else if l.rootSelector == "" {
+		return name
+	}
+	return l.rootSelector + "." + name
+}
+
 func (p *structLocator) Kind() string {
 	return p.kind
 }
@@ -0,0 +1,14 @@
+package locator
+
+import "context"
+
+// Entry represents named locator value
+type Entry struct {
+	Name  string
+	Value interface{}
+}
+
+// Enumerator represents optional locator interface listing all values ordered by name, i.e. for collection bindings
+type Enumerator interface {
+	Entries(ctx context.Context) ([]*Entry, error)
+}
@@ -0,0 +1,12 @@
+package locator
+
+import (
+	"context"
+	"reflect"
+)
+
+// Matcher represents optional locator interface locating value by destination type, i.e. instance implementing an interface.
+// Providers implementing Matcher support name qualified bindings
+type Matcher interface {
+	Match(ctx context.Context, name string, destType reflect.Type) (interface{}, bool, error)
+}
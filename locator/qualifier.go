@@ -0,0 +1,20 @@
+package locator
+
+import "strings"
+
+// QualifierSeparator separates interface type name and qualifier in interface location, i.e. app.DB#readonly
+const QualifierSeparator = "#"
+
+// QualifiedName returns name with qualifier, name is returned for empty qualifier
+func QualifiedName(name, qualifier string) string {
+	if qualifier == "" {
+		return name
+	}
+	return name + QualifierSeparator + qualifier
+}
+
+// SplitQualifiedName splits qualified name into name and qualifier
+func SplitQualifiedName(qualifiedName string) (string, string) {
+	name, qualifier, _ := strings.Cut(qualifiedName, QualifierSeparator)
+	return name, qualifier
+}
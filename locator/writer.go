@@ -0,0 +1,8 @@
+package locator
+
+import "context"
+
+// Writer represents optional locator interface writing value back to its source
+type Writer interface {
+	SetValue(ctx context.Context, name string, value interface{}) error
+}
@@ -0,0 +1,76 @@
+package bindly
+
+import (
+	"context"
+	"fmt"
+	"github.com/viant/bindly/types"
+	"github.com/viant/structology"
+	"reflect"
+)
+
+type (
+	// Manifest represents binding definitions of registered types, i.e. loaded from JSON or YAML file
+	Manifest struct {
+		Types []*TypeManifest `json:"types" yaml:"types"`
+	}
+
+	// TypeManifest represents bindings of a type registered with types.RegisterType
+	TypeManifest struct {
+		Type       string `json:"type" yaml:"type"`
+		TypeConfig `yaml:",inline"`
+	}
+)
+
+// AddManifest attaches manifest bindings to registered types, manifest is consulted for fields without binding tag
+func (b *Injector) AddManifest(manifest *Manifest) error {
+	for _, typeManifest := range manifest.Types {
+		aType, ok := types.LookupType(typeManifest.Type)
+		if !ok {
+			return fmt.Errorf("failed to add manifest: unknown type %v", typeManifest.Type)
+		}
+		structType := ensureStructType(aType.Type())
+		if structType.Kind() != reflect.Struct {
+			return fmt.Errorf("failed to add manifest: unsupported type %v: %v", typeManifest.Type, structType)
+		}
+		stateType := structology.NewStateType(reflect.PtrTo(structType))
+		fields, _ := b.manifests.Get(structType)
+		merged := make(map[string]*FieldConfig, len(fields)+len(typeManifest.Fields))
+		for k, v := range fields {
+			merged[k] = v
+		}
+		for _, field := range typeManifest.Fields {
+			if stateType.Lookup(field.Path) == nil {
+				return fmt.Errorf("failed to add manifest: %v: unknown field %v", typeManifest.Type, field.Path)
+			}
+			merged[field.Path] = field
+		}
+		b.manifests.Put(structType, merged)
+		b.bindingCache.DeleteType(reflect.PtrTo(structType))
+	}
+	return nil
+}
+
+// LoadManifest loads manifest from JSON or YAML (.yaml, .yml) file
+func (b *Injector) LoadManifest(ctx context.Context, URL string) error {
+	manifest := &Manifest{}
+	if err := loadURL(ctx, URL, manifest); err != nil {
+		return err
+	}
+	return b.AddManifest(manifest)
+}
+
+// manifestConfig returns manifest field configuration merged with explicit field configuration
+func (b *Injector) manifestConfig(structType reflect.Type, selector *structology.Selector, fieldConfig *FieldConfig) (*FieldConfig, bool) {
+	manifestField, ok := b.manifests.Lookup(structType, selector.Path())
+	if !ok {
+		return fieldConfig, fieldConfig != nil
+	}
+	ret := *manifestField
+	if _, hasTag := selector.Tag().Lookup(b.xformTag); hasTag { //transformer tag wins over manifest
+		ret.Transforms, ret.XForm = nil, ""
+	}
+	if fieldConfig != nil {
+		ret.merge(fieldConfig)
+	}
+	return &ret, true
+}
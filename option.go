@@ -2,6 +2,7 @@ package bindly
 
 import (
 	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/types"
 )
 
 type InjectorOption func(*Injector)
@@ -30,6 +31,50 @@ func WithTransformerTag(tag string) InjectorOption {
 	}
 }
 
+// WithEmbedder sets embedder providing embedded file system to transformers, i.e. template
+func WithEmbedder(embedder types.Embedder) InjectorOption {
+	return func(b *Injector) {
+		b.embedder = embedder
+	}
+}
+
+// WithDecodingTag sets struct tag, i.e. json, used to match map keys when decoding map values into struct fields
+func WithDecodingTag(tag string) InjectorOption {
+	return func(b *Injector) {
+		b.decoder.tag = tag
+	}
+}
+
+// WithStrictDecoding reports map keys without matching struct field when decoding map values into structs
+func WithStrictDecoding() InjectorOption {
+	return func(b *Injector) {
+		b.decoder.strict = true
+	}
+}
+
+// WithNestedBindings traverses untagged nested structs, embedded structs and struct pointers for bindings,
+// nested bindings use dotted selector path (i.e. TLS.CertFile) and nil struct pointers are allocated on demand
+func WithNestedBindings() InjectorOption {
+	return func(b *Injector) {
+		b.nested = true
+	}
+}
+
+// WithPrefix sets location prefix prepended to every binding in, i.e. the same DBConfig bound from db.primary or db.replica
+func WithPrefix[T any](prefix string) BindingOption[T] {
+	return func(b *BindingContext[T]) {
+		b.prefix = prefix
+	}
+}
+
+// WithUnexportedAllocation allocates nil unexported struct pointers on nested binding paths, i.e. with WithNestedBindings,
+// unexported fields themselves are bound through structology without this option
+func WithUnexportedAllocation() InjectorOption {
+	return func(b *Injector) {
+		b.allocUnexported = true
+	}
+}
+
 func WithCache[T any](cache *ValueCache) BindingOption[T] {
 	return func(b *BindingContext[T]) {
 		b.valueCache = cache
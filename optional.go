@@ -0,0 +1,61 @@
+package bindly
+
+import (
+	"reflect"
+)
+
+// Optional represents injected value distinguishing missing value from zero value.
+// Valid is set when value was located (or defaulted), Source holds binding location, i.e. setting:port
+type Optional[T any] struct {
+	Value  T
+	Valid  bool
+	Source string
+}
+
+// Get returns value and presence flag
+func (o Optional[T]) Get() (T, bool) {
+	return o.Value, o.Valid
+}
+
+// OrElse returns value if present, otherwise supplied value
+func (o Optional[T]) OrElse(value T) T {
+	if o.Valid {
+		return o.Value
+	}
+	return value
+}
+
+func (o Optional[T]) valueType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func (o Optional[T]) with(value interface{}, source string) interface{} {
+	ret := Optional[T]{Valid: true, Source: source}
+	if value != nil {
+		ret.Value = value.(T)
+	}
+	return ret
+}
+
+func (o Optional[T]) value() (interface{}, bool) {
+	return o.Value, o.Valid
+}
+
+// optional represents Optional[T] of any T
+type optional interface {
+	valueType() reflect.Type
+	with(value interface{}, source string) interface{}
+	value() (interface{}, bool)
+}
+
+var optionalType = reflect.TypeOf((*optional)(nil)).Elem()
+
+// isOptionalType returns true for Optional[T] types
+func isOptionalType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.Implements(optionalType)
+}
+
+// newOptional returns present Optional of supplied type
+func newOptional(t reflect.Type, value interface{}, source string) interface{} {
+	return reflect.Zero(t).Interface().(optional).with(value, source)
+}
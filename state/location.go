@@ -1,6 +1,25 @@
 package state
 
+import "strings"
+
+// DefaultKind represents location kind used when kind is not specified
+const DefaultKind = "state"
+
 type Location struct {
 	Kind string `json:"kind,omitempty" yaml:"kind,omitempty"`
 	In   string `json:"in,omitempty" yaml:"in,omitempty"`
 }
+
+// String returns location in kind:in format
+func (l *Location) String() string {
+	return l.Kind + ":" + l.In
+}
+
+// ParseLocation parses location in kind:in format, i.e. setting:base_url, location without kind uses default kind
+func ParseLocation(text string) *Location {
+	text = strings.TrimSpace(text)
+	if index := strings.Index(text, ":"); index != -1 {
+		return &Location{Kind: text[:index], In: text[index+1:]}
+	}
+	return &Location{Kind: DefaultKind, In: text}
+}
@@ -3,7 +3,7 @@ package bindly
 import (
 	"context"
 	"embed"
-	"fmt"
+	"github.com/viant/bindly/state"
 	"github.com/viant/tagly/tags"
 )
 
@@ -25,35 +25,52 @@ func (b *Injector) extractBinding(aBinding *Binding) {
 			aBinding.location.Kind = value
 		case "cacheable":
 			aBinding.cachable = true
+		case "required":
+			aBinding.required = true
+		case "secret":
+			aBinding.secret = true
+		case "all":
+			aBinding.all = true
+		case "name":
+			aBinding.qualifier = value
 		}
 		return nil
 	})
 
 	if aBinding.location.Kind == "" && aBinding.location.In != "" {
-		aBinding.location.Kind = "state"
+		aBinding.location.Kind = state.DefaultKind
 	}
 
 }
 
+// bindingPrefix returns prefix declared with binding tag, i.e. bind:"prefix=db.primary"
+func bindingPrefix(tag string) (string, bool) {
+	prefix, ok := "", false
+	_ = tags.Values(tag).MatchPairs(func(key, value string) error {
+		if key == "prefix" {
+			prefix, ok = value, true
+		}
+		return nil
+	})
+	return prefix, ok
+}
+
 const xFormTag = "xform"
 
-// extractTransformer extracts transformer from struct tag
-func (b *Injector) extractTransformer(ctx context.Context, aBinding *Binding, embedFs *embed.FS) error {
-	// Check if field has a transformer configured
-	name := ""
+// extractTransformer extracts transformer from field config or struct tag, stages separated by | are composed into a pipeline
+func (b *Injector) extractTransformer(ctx context.Context, aBinding *Binding, fieldConfig *FieldConfig, embedFs *embed.FS) error {
+	// Check if field has a transformer configured, explicit configuration takes precedence over tag
 	tag, ok := aBinding.selector.Tag().Lookup(b.xformTag)
-	if !ok {
-		return nil
+	if fieldConfig != nil && fieldConfig.hasTransform() {
+		tag, ok = string(fieldConfig.transformTag()), true
 	}
-	tagValue := tags.Values(tag)
-	name, tagValue = tagValue.Name()
-	factory, ok := b.transformers.Lookup(name)
 	if !ok {
-		return fmt.Errorf("failed to lookup transformer: %v", name)
+		return nil
 	}
-	transformer, err := factory.Create(ctx, tagValue, aBinding.selector.Type(), embedFs)
+	aBinding.xformConfig = tags.Values(tag)
+	transformer, err := b.transformers.Create(ctx, aBinding.xformConfig, aBinding.valueType(), embedFs)
 	if err != nil {
-		return fmt.Errorf("failed to create transformer: %v, %w", name, err)
+		return err
 	}
 	aBinding.transformer = transformer
 	return nil
@@ -16,3 +16,16 @@ type FSEmbedder struct {
 	embedder Embedder
 	rType    reflect.Type
 }
+
+// EmbedFS returns embedded file system
+func (e *FSEmbedder) EmbedFS() *embed.FS {
+	if e.fs == nil && e.embedder != nil {
+		return e.embedder.EmbedFS()
+	}
+	return e.fs
+}
+
+// NewFSEmbedder creates fs embedder
+func NewFSEmbedder(fs *embed.FS) *FSEmbedder {
+	return &FSEmbedder{fs: fs}
+}
@@ -14,6 +14,36 @@ type TransformerBase struct {
 	embedFS  *embed.FS
 }
 
+// Name returns transformer name
+func (b *TransformerBase) Name() string {
+	return b.name
+}
+
+// DestType returns transformer destination type
+func (b *TransformerBase) DestType() reflect.Type {
+	return b.destType
+}
+
+// Config returns transformer tag config
+func (b *TransformerBase) Config() tags.Values {
+	return b.config
+}
+
+// EmbedFS returns transformer embedded file system
+func (b *TransformerBase) EmbedFS() *embed.FS {
+	return b.embedFS
+}
+
+// InputType returns transformer input type, any by default
+func (b *TransformerBase) InputType() reflect.Type {
+	return AnyType
+}
+
+// OutputType returns transformer output type
+func (b *TransformerBase) OutputType() reflect.Type {
+	return b.destType
+}
+
 // NewTransformerBase creates a new transformer base
 func NewTransformerBase(name string, destType reflect.Type, config tags.Values, embedFS *embed.FS) TransformerBase {
 	return TransformerBase{
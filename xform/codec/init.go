@@ -0,0 +1,9 @@
+package codec
+
+import "github.com/viant/bindly/xform"
+
+// Init standard decoding transformers
+func Init(registry *xform.Registry) {
+	registry.Register("json", xform.NewTransformerFactory("json", NewJSONTransformer))
+	registry.Register("yaml", xform.NewTransformerFactory("yaml", NewYAMLTransformer))
+}
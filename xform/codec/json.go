@@ -0,0 +1,77 @@
+package codec
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"reflect"
+)
+
+// JSONTransformer decodes string, []byte or map input into destination type
+type JSONTransformer struct {
+	xform.TransformerBase
+	strict bool
+}
+
+func (t *JSONTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	data, err := asJSON(input)
+	if err != nil {
+		return nil, err
+	}
+	result := reflect.New(t.DestType())
+	if len(bytes.TrimSpace(data)) == 0 {
+		return result.Elem().Interface(), nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if t.strict {
+		decoder.DisallowUnknownFields()
+	}
+	if err = decoder.Decode(result.Interface()); err != nil {
+		return nil, fmt.Errorf("failed to decode json into %v: %w", t.DestType(), err)
+	}
+	return result.Elem().Interface(), nil
+}
+
+// Reverse encodes value as JSON text
+func (t *JSONTransformer) Reverse(ctx context.Context, resolver locator.Resolver, value interface{}) (interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %T as json: %w", value, err)
+	}
+	return string(data), nil
+}
+
+func asJSON(input interface{}) ([]byte, error) {
+	switch actual := input.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []byte(actual), nil
+	case []byte:
+		return actual, nil
+	case json.RawMessage:
+		return actual, nil
+	}
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %T as json: %w", input, err)
+	}
+	return data, nil
+}
+
+// NewJSONTransformer creates a new json transformer, strict parameter disallows unknown fields
+func NewJSONTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	strict, err := xform.NewParameters(config).Bool("strict")
+	if err != nil {
+		return nil, err
+	}
+	return &JSONTransformer{
+		TransformerBase: xform.NewTransformerBase("json", destType, config, embedFS),
+		strict:          strict,
+	}, nil
+}
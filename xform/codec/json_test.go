@@ -0,0 +1,46 @@
+package codec
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"testing"
+)
+
+type poolConfig struct {
+	Size    int
+	Timeout string
+}
+
+func TestJSONTransformer_Transform(t *testing.T) {
+	var testCases = []struct {
+		description string
+		config      string
+		destType    reflect.Type
+		input       interface{}
+		expect      interface{}
+		expectErr   bool
+	}{
+		{description: "string input", destType: reflect.TypeOf(poolConfig{}), input: `{"Size":10,"Timeout":"5s"}`, expect: poolConfig{Size: 10, Timeout: "5s"}},
+		{description: "map input", destType: reflect.TypeOf(&poolConfig{}), input: map[string]interface{}{"size": 3}, expect: &poolConfig{Size: 3}},
+		{description: "slice destination", destType: reflect.TypeOf([]int{}), input: []byte(`[1,2,3]`), expect: []int{1, 2, 3}},
+		{description: "unknown field", destType: reflect.TypeOf(poolConfig{}), input: `{"Size":10,"Other":1}`, expect: poolConfig{Size: 10}},
+		{description: "strict unknown field", config: "strict", destType: reflect.TypeOf(poolConfig{}), input: `{"Size":10,"Other":1}`, expectErr: true},
+		{description: "invalid json", destType: reflect.TypeOf(poolConfig{}), input: `{`, expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		transformer, err := NewJSONTransformer(context.Background(), tags.Values(testCase.config), testCase.destType, nil)
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		actual, err := transformer.Transform(context.Background(), nil, testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}
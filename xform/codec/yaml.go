@@ -0,0 +1,72 @@
+package codec
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"gopkg.in/yaml.v3"
+	"io"
+	"reflect"
+)
+
+// YAMLTransformer decodes string, []byte or map input into destination type
+type YAMLTransformer struct {
+	xform.TransformerBase
+	strict bool
+}
+
+func (t *YAMLTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	data, err := asYAML(input)
+	if err != nil {
+		return nil, err
+	}
+	result := reflect.New(t.DestType())
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(t.strict)
+	if err = decoder.Decode(result.Interface()); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to decode yaml into %v: %w", t.DestType(), err)
+	}
+	return result.Elem().Interface(), nil
+}
+
+// Reverse encodes value as YAML text
+func (t *YAMLTransformer) Reverse(ctx context.Context, resolver locator.Resolver, value interface{}) (interface{}, error) {
+	data, err := yaml.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %T as yaml: %w", value, err)
+	}
+	return string(data), nil
+}
+
+func asYAML(input interface{}) ([]byte, error) {
+	switch actual := input.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []byte(actual), nil
+	case []byte:
+		return actual, nil
+	}
+	data, err := yaml.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %T as yaml: %w", input, err)
+	}
+	return data, nil
+}
+
+// NewYAMLTransformer creates a new yaml transformer, strict parameter disallows unknown fields
+func NewYAMLTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	strict, err := xform.NewParameters(config).Bool("strict")
+	if err != nil {
+		return nil, err
+	}
+	return &YAMLTransformer{
+		TransformerBase: xform.NewTransformerBase("yaml", destType, config, embedFS),
+		strict:          strict,
+	}, nil
+}
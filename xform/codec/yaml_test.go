@@ -0,0 +1,37 @@
+package codec
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"testing"
+)
+
+func TestYAMLTransformer_Transform(t *testing.T) {
+	var testCases = []struct {
+		description string
+		config      string
+		input       interface{}
+		expect      interface{}
+		expectErr   bool
+	}{
+		{description: "string input", input: "size: 10\ntimeout: 5s\n", expect: poolConfig{Size: 10, Timeout: "5s"}},
+		{description: "map input", input: map[string]interface{}{"size": 3}, expect: poolConfig{Size: 3}},
+		{description: "strict unknown field", config: "strict", input: "size: 10\nother: 1\n", expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		transformer, err := NewYAMLTransformer(context.Background(), tags.Values(testCase.config), reflect.TypeOf(poolConfig{}), nil)
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		actual, err := transformer.Transform(context.Background(), nil, testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}
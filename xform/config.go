@@ -1,7 +1,40 @@
 package xform
 
+import (
+	"github.com/viant/tagly/tags"
+	"strings"
+)
+
+// Config represents transformer declared outside struct tag, parameters use key=value form, i.e. layout=DateOnly
 type Config struct {
 	Name       string            `json:"name,omitempty" yaml:"name,omitempty"`
 	Meta       map[string]string `json:"meta,omitempty" yaml:"meta,omitempty"`
 	Parameters []string          `json:"parameters,omitempty" yaml:"parameters,omitempty"`
 }
+
+// Tag returns transformer tag value, parameter values containing , or | are single-quoted
+func (c *Config) Tag() tags.Values {
+	elements := []string{c.Name}
+	for _, parameter := range c.Parameters {
+		key, value, hasValue := strings.Cut(parameter, "=")
+		if !hasValue {
+			elements = append(elements, key)
+			continue
+		}
+		isQuoted := len(value) > 1 && strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'")
+		if !isQuoted && strings.ContainsAny(value, ",|") {
+			value = "'" + strings.ReplaceAll(value, "'", `\'`) + "'"
+		}
+		elements = append(elements, key+"="+value)
+	}
+	return tags.Values(strings.Join(elements, ","))
+}
+
+// PipelineTag returns transformer tag value for supplied configs joined with |
+func PipelineTag(configs ...*Config) tags.Values {
+	stages := make([]string, len(configs))
+	for i, config := range configs {
+		stages[i] = string(config.Tag())
+	}
+	return tags.Values(strings.Join(stages, "|"))
+}
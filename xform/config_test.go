@@ -0,0 +1,25 @@
+package xform_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"testing"
+)
+
+func TestConfig_Tag(t *testing.T) {
+	var testCases = []struct {
+		description string
+		configs     []*xform.Config
+		expect      tags.Values
+	}{
+		{description: "name", configs: []*xform.Config{{Name: "duration"}}, expect: "duration"},
+		{description: "parameters", configs: []*xform.Config{{Name: "time", Parameters: []string{"layout=DateOnly", "tz=UTC"}}}, expect: "time,layout=DateOnly,tz=UTC"},
+		{description: "flag", configs: []*xform.Config{{Name: "json", Parameters: []string{"strict"}}}, expect: "json,strict"},
+		{description: "quoted", configs: []*xform.Config{{Name: "regex", Parameters: []string{"pattern=^(a|b)$"}}}, expect: "regex,pattern='^(a|b)$'"},
+		{description: "pipeline", configs: []*xform.Config{{Name: "trim"}, {Name: "enum", Parameters: []string{"values=a;b"}}}, expect: "trim|enum,values=a;b"},
+	}
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expect, xform.PipelineTag(testCase.configs...), testCase.description)
+	}
+}
@@ -42,6 +42,7 @@ func (t *BoolTransformer) Transform(ctx context.Context, resolver locator.Resolv
 
 // NewBoolTransformer creates a new bool transformer
 func NewBoolTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	destType = ensureDestType(destType, reflect.TypeOf(false))
 	if destType.Kind() != reflect.Bool {
 		return nil, fmt.Errorf("BoolTransformer can only be used with bool destination type, got %v", destType)
 	}
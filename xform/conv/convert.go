@@ -0,0 +1,50 @@
+package conv
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"reflect"
+)
+
+// Convert converts value to destination type with standard transformers, i.e. string, number, bool, duration or time
+func Convert(value interface{}, destType reflect.Type) (interface{}, error) {
+	if value == nil {
+		return reflect.Zero(destType).Interface(), nil
+	}
+	valueType := reflect.TypeOf(value)
+	if valueType.AssignableTo(destType) {
+		return value, nil
+	}
+	var constructor func(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error)
+	switch {
+	case destType.Kind() == reflect.Int64 && durationType.ConvertibleTo(destType) && !IsNumeric(valueType.Kind()):
+		constructor = NewDurationTransformer
+	case IsNumeric(destType.Kind()):
+		return Number(value, destType)
+	case destType == timeType || destType.Kind() == reflect.Ptr && destType.Elem() == timeType:
+		constructor = NewTimeTransformer
+	case destType.Kind() == reflect.Bool:
+		constructor = NewBoolTransformer
+	case destType.Kind() == reflect.String:
+		constructor = NewStringTransformer
+	case valueType.Kind() == destType.Kind() && valueType.ConvertibleTo(destType):
+		return reflect.ValueOf(value).Convert(destType).Interface(), nil
+	default:
+		return nil, fmt.Errorf("cannot convert %T to %v", value, destType)
+	}
+	transformer, err := constructor(context.Background(), "", destType, nil)
+	if err != nil {
+		return nil, err
+	}
+	result, err := transformer.Transform(context.Background(), nil, value)
+	if err != nil {
+		return nil, err
+	}
+	if resultValue := reflect.ValueOf(result); resultValue.Type() != destType { //named types, i.e. type Level string
+		return resultValue.Convert(destType).Interface(), nil
+	}
+	return result, nil
+}
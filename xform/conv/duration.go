@@ -0,0 +1,134 @@
+package conv
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// DurationTransformer converts compatible values to time.Duration
+// Strings use time.ParseDuration syntax (i.e. 5s, 1h30m), numeric values use unit parameter (ns by default)
+type DurationTransformer struct {
+	xform.TransformerBase
+	unit time.Duration
+}
+
+func (t *DurationTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	duration, err := t.duration(input)
+	if err != nil {
+		return nil, err
+	}
+	return reflect.ValueOf(duration).Convert(t.DestType()).Interface(), nil
+}
+
+// Reverse converts duration to text (i.e. 1m30s), or to number of units if unit parameter was specified
+func (t *DurationTransformer) Reverse(ctx context.Context, resolver locator.Resolver, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	rValue := reflect.ValueOf(value)
+	if rValue.Kind() != reflect.Int64 {
+		return nil, fmt.Errorf("cannot reverse %T as duration", value)
+	}
+	duration := time.Duration(rValue.Int())
+	if _, ok := xform.NewParameters(t.Config()).Lookup("unit"); ok {
+		return int64(duration / t.unit), nil
+	}
+	return duration.String(), nil
+}
+
+func (t *DurationTransformer) duration(input interface{}) (time.Duration, error) {
+	switch actual := input.(type) {
+	case nil:
+		return 0, nil
+	case time.Duration:
+		return actual, nil
+	case *time.Duration:
+		if actual == nil {
+			return 0, nil
+		}
+		return *actual, nil
+	case []byte:
+		return t.duration(string(actual))
+	case string:
+		text := strings.TrimSpace(actual)
+		if text == "" {
+			return 0, nil
+		}
+		if duration, err := time.ParseDuration(text); err == nil {
+			return duration, nil
+		}
+		duration, err := t.fromNumber(text)
+		if err != nil {
+			return 0, fmt.Errorf("cannot convert string '%s' to duration", actual)
+		}
+		return duration, nil
+	}
+	return t.fromNumber(input)
+}
+
+func (t *DurationTransformer) fromNumber(input interface{}) (time.Duration, error) {
+	if value, err := Number(input, reflect.TypeOf(int64(0))); err == nil {
+		units := value.(int64)
+		if units > math.MaxInt64/int64(t.unit) || units < math.MinInt64/int64(t.unit) {
+			return 0, fmt.Errorf("cannot convert %v%v to duration: value out of range", units, t.unit)
+		}
+		return time.Duration(units) * t.unit, nil
+	}
+	value, err := Number(input, reflect.TypeOf(float64(0)))
+	if err != nil {
+		return 0, fmt.Errorf("cannot convert %T to duration: %w", input, err)
+	}
+	result := value.(float64) * float64(t.unit)
+	if result >= math.MaxInt64 || result < math.MinInt64 {
+		return 0, fmt.Errorf("cannot convert %v to duration: value out of range", input)
+	}
+	return time.Duration(result), nil
+}
+
+// DurationUnit returns duration for supplied unit name
+func DurationUnit(unit string) (time.Duration, error) {
+	switch strings.ToLower(unit) {
+	case "", "ns", "nanosecond", "nanoseconds":
+		return time.Nanosecond, nil
+	case "us", "µs", "microsecond", "microseconds":
+		return time.Microsecond, nil
+	case "ms", "millisecond", "milliseconds":
+		return time.Millisecond, nil
+	case "s", "sec", "second", "seconds":
+		return time.Second, nil
+	case "m", "min", "minute", "minutes":
+		return time.Minute, nil
+	case "h", "hour", "hours":
+		return time.Hour, nil
+	case "d", "day", "days":
+		return 24 * time.Hour, nil
+	}
+	return 0, fmt.Errorf("unsupported duration unit: %v", unit)
+}
+
+// NewDurationTransformer creates a new duration transformer
+func NewDurationTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	destType = ensureDestType(destType, durationType)
+	if destType.Kind() != reflect.Int64 || !durationType.ConvertibleTo(destType) {
+		return nil, fmt.Errorf("DurationTransformer can only be used with time.Duration destination type, got %v", destType)
+	}
+	params := xform.NewParameters(config)
+	unit, err := DurationUnit(params.Value("unit", "ns"))
+	if err != nil {
+		return nil, err
+	}
+	return &DurationTransformer{
+		TransformerBase: xform.NewTransformerBase("duration", destType, config, embedFS),
+		unit:            unit,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package conv
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"reflect"
+)
+
+// FloatTransformer converts compatible values to float
+type FloatTransformer struct {
+	xform.TransformerBase
+}
+
+func (t *FloatTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	return Number(input, t.DestType())
+}
+
+// NewFloatTransformer creates a new float transformer
+func NewFloatTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	destType = ensureDestType(destType, reflect.TypeOf(float64(0)))
+	if !isFloat(destType.Kind()) {
+		return nil, fmt.Errorf("FloatTransformer can only be used with float destination types, got %v", destType)
+	}
+	return &FloatTransformer{
+		TransformerBase: xform.NewTransformerBase("float", destType, config, embedFS),
+	}, nil
+}
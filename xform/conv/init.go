@@ -1,10 +1,26 @@
 package conv
 
-import "github.com/viant/bindly/xform"
+import (
+	"github.com/viant/bindly/xform"
+	"reflect"
+)
 
 // Init standard transformers
 func Init(registry *xform.Registry) {
 	registry.Register("string", xform.NewTransformerFactory("string", NewStringTransformer))
 	registry.Register("int", xform.NewTransformerFactory("int", NewIntTransformer))
+	registry.Register("uint", xform.NewTransformerFactory("uint", NewUintTransformer))
+	registry.Register("float", xform.NewTransformerFactory("float", NewFloatTransformer))
+	registry.Register("number", xform.NewTransformerFactory("number", NewNumberTransformer))
 	registry.Register("bool", xform.NewTransformerFactory("bool", NewBoolTransformer))
+	registry.Register("duration", xform.NewTransformerFactory("duration", NewDurationTransformer))
+	registry.Register("time", xform.NewTransformerFactory("time", NewTimeTransformer))
+}
+
+// ensureDestType returns default type for untyped (interface) destination, i.e. intermediate pipeline stage
+func ensureDestType(destType reflect.Type, defaultType reflect.Type) reflect.Type {
+	if destType.Kind() == reflect.Interface && defaultType.Implements(destType) {
+		return defaultType
+	}
+	return destType
 }
@@ -0,0 +1,237 @@
+package conv
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// NumberTransformer converts compatible values to any numeric type
+type NumberTransformer struct {
+	xform.TransformerBase
+}
+
+func (t *NumberTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	return Number(input, t.DestType())
+}
+
+// NewNumberTransformer creates a new number transformer
+func NewNumberTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	destType = ensureDestType(destType, reflect.TypeOf(float64(0)))
+	if !IsNumeric(destType.Kind()) {
+		return nil, fmt.Errorf("NumberTransformer can only be used with numeric destination types, got %v", destType)
+	}
+	return &NumberTransformer{
+		TransformerBase: xform.NewTransformerBase("number", destType, config, embedFS),
+	}, nil
+}
+
+// IsNumeric returns true if kind is an int, uint, float or complex kind
+func IsNumeric(kind reflect.Kind) bool {
+	return isInt(kind) || isUint(kind) || isFloat(kind) || isComplex(kind)
+}
+
+func isInt(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+func isUint(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+func isFloat(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+func isComplex(kind reflect.Kind) bool {
+	return kind == reflect.Complex64 || kind == reflect.Complex128
+}
+
+// Number converts input to numeric destType, including named types with numeric underlying kind.
+// Strings are parsed with Go literal syntax (0x, 0o, 0b prefixes and underscores),
+// conversions that overflow or lose precision return an error.
+func Number(input interface{}, destType reflect.Type) (interface{}, error) {
+	if !IsNumeric(destType.Kind()) {
+		return nil, fmt.Errorf("cannot convert %T to %v: not a numeric type", input, destType)
+	}
+	result := reflect.New(destType).Elem()
+	if input == nil {
+		return result.Interface(), nil
+	}
+	value := reflect.ValueOf(input)
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return result.Interface(), nil
+		}
+		value = value.Elem()
+	}
+	var err error
+	switch kind := value.Kind(); {
+	case isInt(kind):
+		err = setInt(result, value.Int())
+	case isUint(kind):
+		err = setUint(result, value.Uint())
+	case isFloat(kind):
+		err = setFloat(result, value.Float())
+	case isComplex(kind):
+		err = setComplex(result, value.Complex())
+	case kind == reflect.String:
+		err = parseNumber(result, value.String())
+	case kind == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8:
+		err = parseNumber(result, string(value.Bytes()))
+	default:
+		err = fmt.Errorf("unsupported source type")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert %v (%T) to %v: %w", input, input, destType, err)
+	}
+	return result.Interface(), nil
+}
+
+func setInt(dest reflect.Value, value int64) error {
+	switch kind := dest.Kind(); {
+	case isInt(kind):
+		if dest.OverflowInt(value) {
+			return errOverflow
+		}
+		dest.SetInt(value)
+	case isUint(kind):
+		if value < 0 {
+			return errNegative
+		}
+		return setUint(dest, uint64(value))
+	case isFloat(kind), isComplex(kind):
+		f := float64(value)
+		if kind == reflect.Float32 || kind == reflect.Complex64 {
+			f = float64(float32(f))
+		}
+		if f >= math.MaxInt64 || int64(f) != value {
+			return errPrecision
+		}
+		return setFloat(dest, f)
+	}
+	return nil
+}
+
+func setUint(dest reflect.Value, value uint64) error {
+	switch kind := dest.Kind(); {
+	case isInt(kind):
+		if value > math.MaxInt64 {
+			return errOverflow
+		}
+		return setInt(dest, int64(value))
+	case isUint(kind):
+		if dest.OverflowUint(value) {
+			return errOverflow
+		}
+		dest.SetUint(value)
+	case isFloat(kind), isComplex(kind):
+		f := float64(value)
+		if kind == reflect.Float32 || kind == reflect.Complex64 {
+			f = float64(float32(f))
+		}
+		if f >= math.MaxUint64 || uint64(f) != value {
+			return errPrecision
+		}
+		return setFloat(dest, f)
+	}
+	return nil
+}
+
+func setFloat(dest reflect.Value, value float64) error {
+	kind := dest.Kind()
+	if (isInt(kind) || isUint(kind)) && (math.IsNaN(value) || math.IsInf(value, 0)) {
+		return errOverflow
+	}
+	switch {
+	case isInt(kind):
+		if value != math.Trunc(value) {
+			return errPrecision
+		}
+		if value < math.MinInt64 || value >= math.MaxInt64 {
+			return errOverflow
+		}
+		return setInt(dest, int64(value))
+	case isUint(kind):
+		if value != math.Trunc(value) {
+			return errPrecision
+		}
+		if value < 0 {
+			return errNegative
+		}
+		if value >= math.MaxUint64 {
+			return errOverflow
+		}
+		return setUint(dest, uint64(value))
+	case isFloat(kind):
+		if dest.OverflowFloat(value) {
+			return errOverflow
+		}
+		dest.SetFloat(value)
+	case isComplex(kind):
+		return setComplex(dest, complex(value, 0))
+	}
+	return nil
+}
+
+func setComplex(dest reflect.Value, value complex128) error {
+	if !isComplex(dest.Kind()) {
+		if imag(value) != 0 {
+			return errPrecision
+		}
+		return setFloat(dest, real(value))
+	}
+	if dest.OverflowComplex(value) {
+		return errOverflow
+	}
+	dest.SetComplex(value)
+	return nil
+}
+
+func parseNumber(dest reflect.Value, text string) error {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return fmt.Errorf("empty string")
+	}
+	if value, err := strconv.ParseInt(text, 0, 64); err == nil {
+		return setInt(dest, value)
+	}
+	if value, err := strconv.ParseUint(text, 0, 64); err == nil {
+		return setUint(dest, value)
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	if err == nil {
+		return setFloat(dest, value)
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return errOverflow
+	}
+	if isComplex(dest.Kind()) {
+		if value, err := strconv.ParseComplex(text, 128); err == nil {
+			return setComplex(dest, value)
+		}
+	}
+	return fmt.Errorf("invalid numeric literal %q", text)
+}
+
+var (
+	errOverflow  = errors.New("value out of range")
+	errNegative  = errors.New("negative value for unsigned type")
+	errPrecision = errors.New("value loses precision")
+)
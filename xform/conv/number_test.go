@@ -0,0 +1,52 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/assert"
+	"reflect"
+	"testing"
+)
+
+type port uint16
+
+func TestNumber(t *testing.T) {
+	var testCases = []struct {
+		description string
+		input       interface{}
+		destType    reflect.Type
+		expect      interface{}
+		expectErr   bool
+	}{
+		{description: "float64 to int", input: float64(8080), destType: reflect.TypeOf(0), expect: 8080},
+		{description: "float64 fraction to int", input: 1.5, destType: reflect.TypeOf(0), expectErr: true},
+		{description: "int to int8 overflow", input: 300, destType: reflect.TypeOf(int8(0)), expectErr: true},
+		{description: "negative to uint", input: -1, destType: reflect.TypeOf(uint(0)), expectErr: true},
+		{description: "string to named uint16", input: "8080", destType: reflect.TypeOf(port(0)), expect: port(8080)},
+		{description: "named uint16 overflow", input: "70000", destType: reflect.TypeOf(port(0)), expectErr: true},
+		{description: "hex string", input: "0xff", destType: reflect.TypeOf(int16(0)), expect: int16(255)},
+		{description: "binary string", input: "0b101", destType: reflect.TypeOf(uint8(0)), expect: uint8(5)},
+		{description: "octal string", input: "0o17", destType: reflect.TypeOf(0), expect: 15},
+		{description: "underscores", input: "1_000_000", destType: reflect.TypeOf(int64(0)), expect: int64(1000000)},
+		{description: "float string", input: "1_000.5", destType: reflect.TypeOf(float32(0)), expect: float32(1000.5)},
+		{description: "exponent to int", input: "1e3", destType: reflect.TypeOf(0), expect: 1000},
+		{description: "float32 overflow", input: 1e300, destType: reflect.TypeOf(float32(0)), expectErr: true},
+		{description: "int64 to float64 precision", input: int64(1<<53 + 1), destType: reflect.TypeOf(float64(0)), expectErr: true},
+		{description: "complex string", input: "1+2i", destType: reflect.TypeOf(complex128(0)), expect: complex(1, 2)},
+		{description: "complex to float", input: complex(2, 0), destType: reflect.TypeOf(float64(0)), expect: float64(2)},
+		{description: "complex with imaginary to int", input: complex(2, 1), destType: reflect.TypeOf(0), expectErr: true},
+		{description: "invalid literal", input: "abc", destType: reflect.TypeOf(0), expectErr: true},
+		{description: "bool source", input: true, destType: reflect.TypeOf(0), expectErr: true},
+		{description: "nil input", input: nil, destType: reflect.TypeOf(port(0)), expect: port(0)},
+	}
+
+	for _, testCase := range testCases {
+		actual, err := Number(testCase.input, testCase.destType)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}
@@ -35,6 +35,7 @@ func (t *StringTransformer) Transform(ctx context.Context, resolver locator.Reso
 
 // NewStringTransformer creates a new string transformer
 func NewStringTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	destType = ensureDestType(destType, reflect.TypeOf(""))
 	if destType.Kind() != reflect.String {
 		return nil, fmt.Errorf("StringTransformer can only be used with string destination type, got %v", destType)
 	}
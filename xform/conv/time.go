@@ -0,0 +1,182 @@
+package conv
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// TimeTransformer converts compatible values to time.Time or *time.Time
+// Strings are parsed with layout parameter, then RFC3339, numeric values are Unix epoch in unit parameter (s by default)
+type TimeTransformer struct {
+	xform.TransformerBase
+	layout   string
+	location *time.Location
+	unit     time.Duration
+}
+
+func (t *TimeTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	ts, ok, err := t.time(input)
+	if err != nil {
+		return nil, err
+	}
+	if t.DestType().Kind() == reflect.Ptr {
+		if !ok {
+			return reflect.Zero(t.DestType()).Interface(), nil
+		}
+		return &ts, nil
+	}
+	return ts, nil
+}
+
+// Reverse formats time with layout parameter (RFC3339 by default), or as Unix epoch if only unit parameter was specified
+func (t *TimeTransformer) Reverse(ctx context.Context, resolver locator.Resolver, value interface{}) (interface{}, error) {
+	ts, ok, err := t.time(value)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if _, hasUnit := xform.NewParameters(t.Config()).Lookup("unit"); hasUnit && t.layout == "" {
+		return ts.UnixNano() / int64(t.unit), nil
+	}
+	if t.layout != "" {
+		return ts.Format(t.layout), nil
+	}
+	return ts.Format(time.RFC3339Nano), nil
+}
+
+func (t *TimeTransformer) time(input interface{}) (time.Time, bool, error) {
+	switch actual := input.(type) {
+	case nil:
+		return time.Time{}, false, nil
+	case time.Time:
+		return t.in(actual), true, nil
+	case *time.Time:
+		if actual == nil {
+			return time.Time{}, false, nil
+		}
+		return t.in(*actual), true, nil
+	case []byte:
+		return t.time(string(actual))
+	case string:
+		text := strings.TrimSpace(actual)
+		if text == "" {
+			return time.Time{}, false, nil
+		}
+		if t.layout != "" {
+			if ts, err := time.ParseInLocation(t.layout, text, t.parseLocation()); err == nil {
+				return t.in(ts), true, nil
+			}
+		}
+		if ts, err := time.ParseInLocation(time.RFC3339Nano, text, t.parseLocation()); err == nil {
+			return t.in(ts), true, nil
+		}
+		ts, err := t.fromEpoch(text)
+		if err != nil {
+			if t.layout != "" {
+				return time.Time{}, false, fmt.Errorf("cannot convert string '%s' to time with layout: %v", actual, t.layout)
+			}
+			return time.Time{}, false, fmt.Errorf("cannot convert string '%s' to time", actual)
+		}
+		return ts, true, nil
+	}
+	ts, err := t.fromEpoch(input)
+	return ts, err == nil, err
+}
+
+func (t *TimeTransformer) fromEpoch(input interface{}) (time.Time, error) {
+	value, err := Number(input, reflect.TypeOf(int64(0)))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot convert %T to time: %w", input, err)
+	}
+	epoch := value.(int64)
+	var ts time.Time
+	switch t.unit {
+	case time.Second:
+		ts = time.Unix(epoch, 0)
+	case time.Millisecond:
+		ts = time.UnixMilli(epoch)
+	case time.Microsecond:
+		ts = time.UnixMicro(epoch)
+	case time.Nanosecond:
+		ts = time.Unix(0, epoch)
+	default:
+		seconds := int64(t.unit / time.Second)
+		if epoch > math.MaxInt64/seconds || epoch < math.MinInt64/seconds {
+			return time.Time{}, fmt.Errorf("cannot convert %v%v to time: value out of range", epoch, t.unit)
+		}
+		ts = time.Unix(epoch*seconds, 0)
+	}
+	return t.in(ts), nil
+}
+
+func (t *TimeTransformer) parseLocation() *time.Location {
+	if t.location == nil {
+		return time.UTC
+	}
+	return t.location
+}
+
+func (t *TimeTransformer) in(ts time.Time) time.Time {
+	if t.location == nil {
+		return ts
+	}
+	return ts.In(t.location)
+}
+
+// TimeLayout returns time layout for supplied layout name, i.e. RFC3339, DateOnly, or layout itself
+func TimeLayout(layout string) string {
+	switch layout {
+	case "RFC3339":
+		return time.RFC3339
+	case "RFC3339Nano":
+		return time.RFC3339Nano
+	case "RFC1123":
+		return time.RFC1123
+	case "RFC1123Z":
+		return time.RFC1123Z
+	case "RFC822":
+		return time.RFC822
+	case "DateTime":
+		return time.DateTime
+	case "DateOnly":
+		return time.DateOnly
+	case "TimeOnly":
+		return time.TimeOnly
+	case "Kitchen":
+		return time.Kitchen
+	}
+	return layout
+}
+
+// NewTimeTransformer creates a new time transformer
+func NewTimeTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	destType = ensureDestType(destType, timeType)
+	if destType != timeType && !(destType.Kind() == reflect.Ptr && destType.Elem() == timeType) {
+		return nil, fmt.Errorf("TimeTransformer can only be used with time.Time or *time.Time destination type, got %v", destType)
+	}
+	params := xform.NewParameters(config)
+	unit, err := DurationUnit(params.Value("unit", "s"))
+	if err != nil {
+		return nil, err
+	}
+	ret := &TimeTransformer{
+		TransformerBase: xform.NewTransformerBase("time", destType, config, embedFS),
+		layout:          TimeLayout(params.Value("layout", "")),
+		unit:            unit,
+	}
+	if tz, ok := params.Lookup("tz"); ok && tz != "" {
+		if ret.location, err = time.LoadLocation(tz); err != nil {
+			return nil, fmt.Errorf("invalid tz parameter: %v, %w", tz, err)
+		}
+	}
+	return ret, nil
+}
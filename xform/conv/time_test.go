@@ -0,0 +1,79 @@
+package conv
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/viant/tagly/tags"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDurationTransformer_Transform(t *testing.T) {
+	var testCases = []struct {
+		description string
+		config      string
+		input       interface{}
+		expect      time.Duration
+		expectErr   bool
+	}{
+		{description: "duration string", input: "5s", expect: 5 * time.Second},
+		{description: "compound duration string", input: "1h30m", expect: 90 * time.Minute},
+		{description: "int with ms unit", config: "unit=ms", input: 250, expect: 250 * time.Millisecond},
+		{description: "numeric string with s unit", config: "unit=s", input: "30", expect: 30 * time.Second},
+		{description: "float with s unit", config: "unit=s", input: 1.5, expect: 1500 * time.Millisecond},
+		{description: "duration passthrough", config: "unit=s", input: time.Minute, expect: time.Minute},
+		{description: "invalid string", input: "abc", expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		transformer, err := NewDurationTransformer(context.Background(), tags.Values(testCase.config), reflect.TypeOf(time.Duration(0)), nil)
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		actual, err := transformer.Transform(context.Background(), nil, testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}
+
+func TestTimeTransformer_Transform(t *testing.T) {
+	var testCases = []struct {
+		description string
+		config      string
+		input       interface{}
+		expect      time.Time
+		expectErr   bool
+	}{
+		{description: "layout with tz", config: "layout=2006-01-02,tz=UTC", input: "2024-03-15", expect: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{description: "RFC3339 fallback", config: "tz=UTC", input: "2024-03-15T10:20:30Z", expect: time.Date(2024, 3, 15, 10, 20, 30, 0, time.UTC)},
+		{description: "named layout", config: "layout=DateTime,tz=UTC", input: "2024-03-15 10:20:30", expect: time.Date(2024, 3, 15, 10, 20, 30, 0, time.UTC)},
+		{description: "unix epoch", config: "tz=UTC", input: 1710498030, expect: time.Date(2024, 3, 15, 10, 20, 30, 0, time.UTC)},
+		{description: "unix epoch ms string", config: "tz=UTC,unit=ms", input: "1710498030000", expect: time.Date(2024, 3, 15, 10, 20, 30, 0, time.UTC)},
+		{description: "unix epoch hours", config: "tz=UTC,unit=h", input: 475138, expect: time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC)},
+		{description: "epoch hours out of range", config: "unit=h", input: int64(math.MaxInt64 / 60), expectErr: true},
+		{description: "invalid", config: "layout=2006-01-02", input: "15/03/2024", expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		transformer, err := NewTimeTransformer(context.Background(), tags.Values(testCase.config), reflect.TypeOf(time.Time{}), nil)
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		actual, err := transformer.Transform(context.Background(), nil, testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		assert.True(t, testCase.expect.Equal(actual.(time.Time)), testCase.description)
+		assert.Equal(t, time.UTC, actual.(time.Time).Location(), testCase.description)
+	}
+}
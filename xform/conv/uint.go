@@ -0,0 +1,31 @@
+package conv
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"reflect"
+)
+
+// UintTransformer converts compatible values to unsigned int
+type UintTransformer struct {
+	xform.TransformerBase
+}
+
+func (t *UintTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	return Number(input, t.DestType())
+}
+
+// NewUintTransformer creates a new unsigned int transformer
+func NewUintTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	destType = ensureDestType(destType, reflect.TypeOf(uint(0)))
+	if !isUint(destType.Kind()) {
+		return nil, fmt.Errorf("UintTransformer can only be used with unsigned int destination types, got %v", destType)
+	}
+	return &UintTransformer{
+		TransformerBase: xform.NewTransformerBase("uint", destType, config, embedFS),
+	}, nil
+}
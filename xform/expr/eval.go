@@ -0,0 +1,307 @@
+package expr
+
+import (
+	"context"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// evaluator evaluates expression AST for input and resolver
+type evaluator struct {
+	ctx      context.Context
+	resolver locator.Resolver
+	input    interface{}
+}
+
+func (e *evaluator) eval(aNode node) (interface{}, error) {
+	switch actual := aNode.(type) {
+	case *literalNode:
+		return actual.value, nil
+	case *inputNode:
+		return normalize(e.input), nil
+	case *locationNode:
+		if e.resolver == nil {
+			return nil, fmt.Errorf("failed to resolve %v: resolver was nil", actual.location)
+		}
+		value, _, err := e.resolver.Value(e.ctx, actual.location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve %v: %w", actual.location, err)
+		}
+		return normalize(value), nil
+	case *selectorNode:
+		owner, err := e.eval(actual.owner)
+		if err != nil {
+			return nil, err
+		}
+		return selectField(owner, actual.name)
+	case *unaryNode:
+		operand, err := e.eval(actual.operand)
+		if err != nil {
+			return nil, err
+		}
+		if actual.operator == "!" {
+			return !truthy(operand), nil
+		}
+		switch number := operand.(type) {
+		case int64:
+			return -number, nil
+		case float64:
+			return -number, nil
+		}
+		return nil, fmt.Errorf("invalid operand for -: %T", operand)
+	case *ternaryNode:
+		condition, err := e.eval(actual.condition)
+		if err != nil {
+			return nil, err
+		}
+		if truthy(condition) {
+			return e.eval(actual.onTrue)
+		}
+		return e.eval(actual.onFalse)
+	case *callNode:
+		args := make([]interface{}, len(actual.args))
+		for i, arg := range actual.args {
+			value, err := e.eval(arg)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = value
+		}
+		result, err := functions[actual.name](args...)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", actual.name, err)
+		}
+		return normalize(result), nil
+	case *binaryNode:
+		return e.binary(actual)
+	}
+	return nil, fmt.Errorf("unsupported node: %T", aNode)
+}
+
+func (e *evaluator) binary(aNode *binaryNode) (interface{}, error) {
+	left, err := e.eval(aNode.left)
+	if err != nil {
+		return nil, err
+	}
+	switch aNode.operator { //short circuit
+	case "&&":
+		if !truthy(left) {
+			return false, nil
+		}
+	case "||":
+		if truthy(left) {
+			return true, nil
+		}
+	}
+	right, err := e.eval(aNode.right)
+	if err != nil {
+		return nil, err
+	}
+	switch aNode.operator {
+	case "&&", "||":
+		return truthy(right), nil
+	case "==":
+		return equals(left, right), nil
+	case "!=":
+		return !equals(left, right), nil
+	case "<", "<=", ">", ">=":
+		return compare(aNode.operator, left, right)
+	case "+":
+		leftText, isLeftText := left.(string)
+		rightText, isRightText := right.(string)
+		if isLeftText || isRightText {
+			if !isLeftText {
+				leftText = stringify(left)
+			}
+			if !isRightText {
+				rightText = stringify(right)
+			}
+			return leftText + rightText, nil
+		}
+	}
+	return arithmetic(aNode.operator, left, right)
+}
+
+func arithmetic(operator string, left, right interface{}) (interface{}, error) {
+	leftInt, isLeftInt := left.(int64)
+	rightInt, isRightInt := right.(int64)
+	if isLeftInt && isRightInt {
+		switch operator {
+		case "+":
+			return leftInt + rightInt, nil
+		case "-":
+			return leftInt - rightInt, nil
+		case "*":
+			return leftInt * rightInt, nil
+		case "/", "%":
+			if rightInt == 0 {
+				return nil, fmt.Errorf("division by zero")
+			}
+			if operator == "/" {
+				return leftInt / rightInt, nil
+			}
+			return leftInt % rightInt, nil
+		}
+	}
+	leftFloat, ok := asFloat(left)
+	if !ok {
+		return nil, fmt.Errorf("invalid operand for %v: %T", operator, left)
+	}
+	rightFloat, ok := asFloat(right)
+	if !ok {
+		return nil, fmt.Errorf("invalid operand for %v: %T", operator, right)
+	}
+	switch operator {
+	case "+":
+		return leftFloat + rightFloat, nil
+	case "-":
+		return leftFloat - rightFloat, nil
+	case "*":
+		return leftFloat * rightFloat, nil
+	case "/":
+		if rightFloat == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return leftFloat / rightFloat, nil
+	case "%":
+		if rightFloat == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return math.Mod(leftFloat, rightFloat), nil
+	}
+	return nil, fmt.Errorf("unsupported operator: %v", operator)
+}
+
+func compare(operator string, left, right interface{}) (bool, error) {
+	var result int
+	leftText, isLeftText := left.(string)
+	rightText, isRightText := right.(string)
+	if isLeftText && isRightText {
+		result = strings.Compare(leftText, rightText)
+	} else {
+		leftFloat, ok := asFloat(left)
+		rightFloat, ok2 := asFloat(right)
+		if !ok || !ok2 {
+			return false, fmt.Errorf("cannot compare %T with %T", left, right)
+		}
+		switch {
+		case leftFloat < rightFloat:
+			result = -1
+		case leftFloat > rightFloat:
+			result = 1
+		}
+	}
+	switch operator {
+	case "<":
+		return result < 0, nil
+	case "<=":
+		return result <= 0, nil
+	case ">":
+		return result > 0, nil
+	}
+	return result >= 0, nil
+}
+
+func equals(left, right interface{}) bool {
+	if leftFloat, ok := asFloat(left); ok {
+		if rightFloat, ok := asFloat(right); ok {
+			return leftFloat == rightFloat
+		}
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+func asFloat(value interface{}) (float64, bool) {
+	switch actual := value.(type) {
+	case int64:
+		return float64(actual), true
+	case float64:
+		return actual, true
+	}
+	return 0, false
+}
+
+func truthy(value interface{}) bool {
+	switch actual := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return actual
+	case int64:
+		return actual != 0
+	case float64:
+		return actual != 0
+	case string:
+		return actual != ""
+	}
+	rValue := reflect.ValueOf(value)
+	switch rValue.Kind() {
+	case reflect.Slice, reflect.Map:
+		return rValue.Len() > 0
+	case reflect.Ptr, reflect.Interface:
+		return !rValue.IsNil()
+	}
+	return true
+}
+
+func stringify(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// normalize converts numeric values to int64 or float64, dereferences pointers
+func normalize(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	rValue := reflect.ValueOf(value)
+	for rValue.Kind() == reflect.Ptr {
+		if rValue.IsNil() {
+			return nil
+		}
+		rValue = rValue.Elem()
+	}
+	switch rValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rValue.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rValue.Uint())
+	case reflect.Float32, reflect.Float64:
+		return rValue.Float()
+	case reflect.String:
+		return rValue.String()
+	case reflect.Bool:
+		return rValue.Bool()
+	}
+	return rValue.Interface()
+}
+
+func selectField(owner interface{}, name string) (interface{}, error) {
+	if owner == nil {
+		return nil, nil
+	}
+	rValue := reflect.ValueOf(owner)
+	switch rValue.Kind() {
+	case reflect.Map:
+		if rValue.Type().Key().Kind() != reflect.String {
+			break
+		}
+		value := rValue.MapIndex(reflect.ValueOf(name).Convert(rValue.Type().Key()))
+		if !value.IsValid() {
+			return nil, nil
+		}
+		return normalize(value.Interface()), nil
+	case reflect.Struct:
+		field := rValue.FieldByName(name)
+		if !field.IsValid() || !field.CanInterface() {
+			return nil, fmt.Errorf("unknown field %v in %T", name, owner)
+		}
+		return normalize(field.Interface()), nil
+	}
+	return nil, fmt.Errorf("cannot select %v from %T", name, owner)
+}
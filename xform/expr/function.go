@@ -0,0 +1,143 @@
+package expr
+
+import (
+	"fmt"
+	"github.com/viant/bindly/xform/conv"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// function represents built-in expression function
+type function func(args ...interface{}) (interface{}, error)
+
+var functions = map[string]function{
+	"len":       lenFn,
+	"lower":     stringFn(strings.ToLower),
+	"upper":     stringFn(strings.ToUpper),
+	"trim":      stringFn(strings.TrimSpace),
+	"contains":  stringPredicateFn(strings.Contains),
+	"hasPrefix": stringPredicateFn(strings.HasPrefix),
+	"hasSuffix": stringPredicateFn(strings.HasSuffix),
+	"replace":   replaceFn,
+	"default":   defaultFn,
+	"string":    stringConvFn,
+	"int":       numberFn(reflect.TypeOf(int64(0))),
+	"float":     numberFn(reflect.TypeOf(float64(0))),
+	"bool":      boolFn,
+	"min":       minMaxFn(true),
+	"max":       minMaxFn(false),
+	"format":    formatFn,
+}
+
+func expectArgs(args []interface{}, count int) error {
+	if len(args) != count {
+		return fmt.Errorf("expected %v argument(s), but had %v", count, len(args))
+	}
+	return nil
+}
+
+func lenFn(args ...interface{}) (interface{}, error) {
+	if err := expectArgs(args, 1); err != nil {
+		return nil, err
+	}
+	if args[0] == nil {
+		return 0, nil
+	}
+	if text, ok := args[0].(string); ok {
+		return len([]rune(text)), nil
+	}
+	value := reflect.ValueOf(args[0])
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return value.Len(), nil
+	}
+	return nil, fmt.Errorf("unsupported argument type: %T", args[0])
+}
+
+func stringFn(fn func(string) string) function {
+	return func(args ...interface{}) (interface{}, error) {
+		if err := expectArgs(args, 1); err != nil {
+			return nil, err
+		}
+		return fn(stringify(args[0])), nil
+	}
+}
+
+func stringPredicateFn(fn func(string, string) bool) function {
+	return func(args ...interface{}) (interface{}, error) {
+		if err := expectArgs(args, 2); err != nil {
+			return nil, err
+		}
+		return fn(stringify(args[0]), stringify(args[1])), nil
+	}
+}
+
+func replaceFn(args ...interface{}) (interface{}, error) {
+	if err := expectArgs(args, 3); err != nil {
+		return nil, err
+	}
+	return strings.ReplaceAll(stringify(args[0]), stringify(args[1]), stringify(args[2])), nil
+}
+
+func defaultFn(args ...interface{}) (interface{}, error) {
+	if err := expectArgs(args, 2); err != nil {
+		return nil, err
+	}
+	if truthy(args[0]) {
+		return args[0], nil
+	}
+	return args[1], nil
+}
+
+func stringConvFn(args ...interface{}) (interface{}, error) {
+	if err := expectArgs(args, 1); err != nil {
+		return nil, err
+	}
+	return stringify(args[0]), nil
+}
+
+func numberFn(destType reflect.Type) function {
+	return func(args ...interface{}) (interface{}, error) {
+		if err := expectArgs(args, 1); err != nil {
+			return nil, err
+		}
+		return conv.Number(args[0], destType)
+	}
+}
+
+func boolFn(args ...interface{}) (interface{}, error) {
+	if err := expectArgs(args, 1); err != nil {
+		return nil, err
+	}
+	if text, ok := args[0].(string); ok {
+		return strconv.ParseBool(text)
+	}
+	return truthy(args[0]), nil
+}
+
+func minMaxFn(isMin bool) function {
+	return func(args ...interface{}) (interface{}, error) {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("expected at least one argument")
+		}
+		result := args[0]
+		for _, arg := range args[1:] {
+			isLess, err := compare("<", arg, result)
+			if err != nil {
+				return nil, err
+			}
+			if isLess == isMin && !equals(arg, result) {
+				result = arg
+			}
+		}
+		return result, nil
+	}
+}
+
+func formatFn(args ...interface{}) (interface{}, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("expected at least one argument")
+	}
+	return fmt.Sprintf(stringify(args[0]), args[1:]...), nil
+}
@@ -0,0 +1,8 @@
+package expr
+
+import "github.com/viant/bindly/xform"
+
+// Init standard expression transformers
+func Init(registry *xform.Registry) {
+	registry.Register("expr", xform.NewTransformerFactory("expr", NewExprTransformer))
+}
@@ -0,0 +1,140 @@
+package expr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	eofToken tokenKind = iota
+	numberToken
+	stringToken
+	identToken
+	locationToken
+	operatorToken
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value interface{}
+	pos   int
+}
+
+var operators = []string{"==", "!=", "<=", ">=", "&&", "||", "+", "-", "*", "/", "%", "<", ">", "!", "?", ":", "(", ")", ",", "."}
+
+// tokenize splits expression into tokens, kind:path immediately followed by path character is a location.
+// Within a ternary branch kind:path is a location only if followed by :, i.e. a ? b:c is a ternary
+func tokenize(code string) ([]*token, error) {
+	var result []*token
+	runes := []rune(code)
+	pending := 0 //ternary operators waiting for :
+	for pos := 0; pos < len(runes); {
+		ch := runes[pos]
+		switch {
+		case unicode.IsSpace(ch):
+			pos++
+		case unicode.IsDigit(ch):
+			end := pos
+			for end < len(runes) && (isIdentRune(runes[end]) || runes[end] == '.' ||
+				((runes[end] == '+' || runes[end] == '-') && (runes[end-1] == 'e' || runes[end-1] == 'E' || runes[end-1] == 'p' || runes[end-1] == 'P'))) {
+				end++
+			}
+			text := string(runes[pos:end])
+			value, err := parseNumber(text)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %v at %v", text, pos)
+			}
+			result = append(result, &token{kind: numberToken, text: text, value: value, pos: pos})
+			pos = end
+		case ch == '"':
+			end := pos + 1
+			for ; end < len(runes) && runes[end] != '"'; end++ {
+				if runes[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at %v", pos)
+			}
+			text := string(runes[pos : end+1])
+			value, err := strconv.Unquote(text)
+			if err != nil {
+				return nil, fmt.Errorf("invalid string %v at %v", text, pos)
+			}
+			result = append(result, &token{kind: stringToken, text: text, value: value, pos: pos})
+			pos = end + 1
+		case isIdentRune(ch):
+			end := pos
+			for end < len(runes) && isIdentRune(runes[end]) {
+				end++
+			}
+			if end+1 < len(runes) && runes[end] == ':' && isPathRune(runes[end+1]) {
+				pathEnd := end + 1
+				for pathEnd < len(runes) && isPathRune(runes[pathEnd]) {
+					pathEnd++
+				}
+				if pending > 0 && !hasNextRune(runes, pathEnd, ':') {
+					result = append(result, &token{kind: identToken, text: string(runes[pos:end]), pos: pos})
+					pos = end
+					continue
+				}
+				text := string(runes[pos:pathEnd])
+				result = append(result, &token{kind: locationToken, text: text, pos: pos})
+				pos = pathEnd
+				continue
+			}
+			result = append(result, &token{kind: identToken, text: string(runes[pos:end]), pos: pos})
+			pos = end
+		default:
+			matched := ""
+			for _, operator := range operators {
+				if strings.HasPrefix(string(runes[pos:]), operator) {
+					matched = operator
+					break
+				}
+			}
+			if matched == "" {
+				return nil, fmt.Errorf("unexpected character %q at %v", ch, pos)
+			}
+			switch matched {
+			case "?":
+				pending++
+			case ":":
+				if pending > 0 {
+					pending--
+				}
+			}
+			result = append(result, &token{kind: operatorToken, text: matched, pos: pos})
+			pos += len(matched)
+		}
+	}
+	return append(result, &token{kind: eofToken, pos: len(runes)}), nil
+}
+
+// hasNextRune returns true if the first non space rune from pos is ch
+func hasNextRune(runes []rune, pos int, ch rune) bool {
+	for pos < len(runes) && unicode.IsSpace(runes[pos]) {
+		pos++
+	}
+	return pos < len(runes) && runes[pos] == ch
+}
+
+func isIdentRune(ch rune) bool {
+	return ch == '_' || unicode.IsLetter(ch) || unicode.IsDigit(ch)
+}
+
+func isPathRune(ch rune) bool {
+	return isIdentRune(ch) || ch == '.' || ch == '-' || ch == '/'
+}
+
+func parseNumber(text string) (interface{}, error) {
+	if value, err := strconv.ParseInt(text, 0, 64); err == nil {
+		return value, nil
+	}
+	return strconv.ParseFloat(text, 64)
+}
@@ -0,0 +1,231 @@
+package expr
+
+import (
+	"fmt"
+	"github.com/viant/bindly/state"
+)
+
+type (
+	// node represents expression AST node
+	node interface{}
+
+	literalNode struct {
+		value interface{}
+	}
+
+	inputNode struct{}
+
+	locationNode struct {
+		location *state.Location
+	}
+
+	unaryNode struct {
+		operator string
+		operand  node
+	}
+
+	binaryNode struct {
+		operator string
+		left     node
+		right    node
+	}
+
+	ternaryNode struct {
+		condition node
+		onTrue    node
+		onFalse   node
+	}
+
+	callNode struct {
+		name string
+		args []node
+	}
+
+	selectorNode struct {
+		owner node
+		name  string
+	}
+
+	parser struct {
+		tokens []*token
+		pos    int
+	}
+)
+
+// binary operators precedence, higher binds tighter
+var precedence = map[string]int{
+	"||": 1,
+	"&&": 2,
+	"==": 3, "!=": 3,
+	"<": 4, "<=": 4, ">": 4, ">=": 4,
+	"+": 5, "-": 5,
+	"*": 6, "/": 6, "%": 6,
+}
+
+// parse parses expression code into AST
+func parse(code string) (node, error) {
+	tokens, err := tokenize(code)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	result, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if next := p.peek(); next.kind != eofToken {
+		return nil, fmt.Errorf("unexpected %v at %v", next.text, next.pos)
+	}
+	return result, nil
+}
+
+func (p *parser) peek() *token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() *token {
+	ret := p.tokens[p.pos]
+	if ret.kind != eofToken {
+		p.pos++
+	}
+	return ret
+}
+
+func (p *parser) isOperator(text string) bool {
+	next := p.peek()
+	return next.kind == operatorToken && next.text == text
+}
+
+func (p *parser) expect(text string) error {
+	if !p.isOperator(text) {
+		next := p.peek()
+		return fmt.Errorf("expected %v at %v, but had %v", text, next.pos, next.text)
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) expression() (node, error) {
+	condition, err := p.binary(1)
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOperator("?") {
+		return condition, nil
+	}
+	p.next()
+	onTrue, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(":"); err != nil {
+		return nil, err
+	}
+	onFalse, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	return &ternaryNode{condition: condition, onTrue: onTrue, onFalse: onFalse}, nil
+}
+
+func (p *parser) binary(minPrecedence int) (node, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		next := p.peek()
+		prec, ok := precedence[next.text]
+		if next.kind != operatorToken || !ok || prec < minPrecedence {
+			return left, nil
+		}
+		p.next()
+		right, err := p.binary(prec + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{operator: next.text, left: left, right: right}
+	}
+}
+
+func (p *parser) unary() (node, error) {
+	if p.isOperator("!") || p.isOperator("-") {
+		operator := p.next().text
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{operator: operator, operand: operand}, nil
+	}
+	return p.postfix()
+}
+
+func (p *parser) postfix() (node, error) {
+	result, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator(".") {
+		p.next()
+		name := p.next()
+		if name.kind != identToken {
+			return nil, fmt.Errorf("expected field name at %v, but had %v", name.pos, name.text)
+		}
+		result = &selectorNode{owner: result, name: name.text}
+	}
+	return result, nil
+}
+
+func (p *parser) primary() (node, error) {
+	next := p.next()
+	switch next.kind {
+	case numberToken, stringToken:
+		return &literalNode{value: next.value}, nil
+	case locationToken:
+		return &locationNode{location: state.ParseLocation(next.text)}, nil
+	case identToken:
+		switch next.text {
+		case "true":
+			return &literalNode{value: true}, nil
+		case "false":
+			return &literalNode{value: false}, nil
+		case "nil", "null":
+			return &literalNode{value: nil}, nil
+		case "input", "_":
+			return &inputNode{}, nil
+		}
+		if !p.isOperator("(") {
+			return nil, fmt.Errorf("unknown identifier %v at %v", next.text, next.pos)
+		}
+		if _, ok := functions[next.text]; !ok {
+			return nil, fmt.Errorf("unknown function %v at %v", next.text, next.pos)
+		}
+		p.next()
+		call := &callNode{name: next.text}
+		for !p.isOperator(")") {
+			if len(call.args) > 0 {
+				if err := p.expect(","); err != nil {
+					return nil, err
+				}
+			}
+			arg, err := p.expression()
+			if err != nil {
+				return nil, err
+			}
+			call.args = append(call.args, arg)
+		}
+		p.next()
+		return call, nil
+	case operatorToken:
+		if next.text == "(" {
+			result, err := p.expression()
+			if err != nil {
+				return nil, err
+			}
+			return result, p.expect(")")
+		}
+	case eofToken:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	return nil, fmt.Errorf("unexpected %v at %v", next.text, next.pos)
+}
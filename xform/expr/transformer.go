@@ -0,0 +1,72 @@
+package expr
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/bindly/xform/conv"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"strings"
+)
+
+// ExprTransformer evaluates expression over input and other locations,
+// i.e. setting:base_url + "/v1", state:Config.Port * 2, input > 0 ? input : 8080
+type ExprTransformer struct {
+	xform.TransformerBase
+	code string
+	root node
+}
+
+func (t *ExprTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	anEvaluator := &evaluator{ctx: ctx, resolver: resolver, input: input}
+	result, err := anEvaluator.eval(t.root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate %v: %w", t.code, err)
+	}
+	return coerce(result, t.DestType())
+}
+
+// coerce converts evaluation result to destination type
+func coerce(value interface{}, destType reflect.Type) (interface{}, error) {
+	if destType.Kind() == reflect.Interface {
+		return value, nil
+	}
+	if value == nil {
+		return reflect.Zero(destType).Interface(), nil
+	}
+	valueType := reflect.TypeOf(value)
+	switch {
+	case valueType.AssignableTo(destType):
+		return value, nil
+	case conv.IsNumeric(destType.Kind()) && conv.IsNumeric(valueType.Kind()):
+		return conv.Number(value, destType)
+	case destType.Kind() == reflect.String:
+		return reflect.ValueOf(stringify(value)).Convert(destType).Interface(), nil
+	case valueType.ConvertibleTo(destType) && valueType.Kind() == destType.Kind():
+		return reflect.ValueOf(value).Convert(destType).Interface(), nil
+	}
+	return nil, fmt.Errorf("cannot convert expression result %T to %v", value, destType)
+}
+
+// NewExprTransformer creates a new expression transformer, code parameter defines expression, optionally enclosed with '...' or {...}
+func NewExprTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	code, ok := xform.NewParameters(config).Lookup("code")
+	if !ok || code == "" {
+		return nil, fmt.Errorf("ExprTransformer requires code parameter")
+	}
+	if strings.HasPrefix(code, "{") && strings.HasSuffix(code, "}") {
+		code = code[1 : len(code)-1]
+	}
+	root, err := parse(code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse expression %v: %w", code, err)
+	}
+	return &ExprTransformer{
+		TransformerBase: xform.NewTransformerBase("expr", destType, config, embedFS),
+		code:            code,
+		root:            root,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package expr
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/viant/bindly/state"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"testing"
+)
+
+type testResolver map[string]interface{}
+
+func (r testResolver) Value(ctx context.Context, location *state.Location) (interface{}, bool, error) {
+	value, ok := r[location.String()]
+	return value, ok, nil
+}
+
+func TestExprTransformer_Transform(t *testing.T) {
+	resolver := testResolver{
+		"setting:base_url":  "http://localhost:8080",
+		"setting:debug":     true,
+		"state:Config.Port": 8080,
+		"setting:names":     []string{"a", "b"},
+		"setting:pool":      map[string]interface{}{"size": 4},
+	}
+	var testCases = []struct {
+		description string
+		config      string
+		destType    reflect.Type
+		input       interface{}
+		expect      interface{}
+		expectErr   bool
+	}{
+		{description: "string concatenation", config: `code='setting:base_url + "/v1"'`, destType: reflect.TypeOf(""), expect: "http://localhost:8080/v1"},
+		{description: "arithmetic", config: `code='state:Config.Port * 2'`, destType: reflect.TypeOf(0), expect: 16160},
+		{description: "precedence", config: `code='1 + 2 * 3 - (4 - 2) / 2'`, destType: reflect.TypeOf(int32(0)), expect: int32(6)},
+		{description: "float arithmetic", config: `code='input / 4'`, destType: reflect.TypeOf(0.0), input: 10.0, expect: 2.5},
+		{description: "ternary", config: `code='setting:debug ? "debug" : "info"'`, destType: reflect.TypeOf(""), expect: "debug"},
+		{description: "comparison and logic", config: `code='input >= 1 && input <= 65535 || false'`, destType: reflect.TypeOf(true), input: 80, expect: true},
+		{description: "input default", config: `code='input > 0 ? input : 8080'`, destType: reflect.TypeOf(uint16(0)), input: 0, expect: uint16(8080)},
+		{description: "compact ternary", config: `code='input > 0 ? input:8080'`, destType: reflect.TypeOf(0), input: 0, expect: 8080},
+		{description: "compact ternary with location", config: `code='setting:debug?setting:base_url:"none"'`, destType: reflect.TypeOf(""), expect: "http://localhost:8080"},
+		{description: "compact nested ternary", config: `code='input > 1 ? 1:input > 0 ? 2:3'`, destType: reflect.TypeOf(0), input: 1, expect: 2},
+		{description: "block code", config: `code={!setting:debug || len(setting:names) == 2}`, destType: reflect.TypeOf(true), expect: true},
+		{description: "functions", config: `code='upper(trim(input)) + format("-%d", len(input))'`, destType: reflect.TypeOf(""), input: " ab ", expect: "AB-4"},
+		{description: "selector", config: `code='(setting:pool).size + 1'`, destType: reflect.TypeOf(0), expect: 5},
+		{description: "missing location", config: `code='default(setting:missing, "x")'`, destType: reflect.TypeOf(""), expect: "x"},
+		{description: "division by zero", config: `code='1 / 0'`, destType: reflect.TypeOf(0), expectErr: true},
+		{description: "invalid operand", config: `code='true * 2'`, destType: reflect.TypeOf(0), expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		transformer, err := NewExprTransformer(context.Background(), tags.Values(testCase.config), testCase.destType, nil)
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		actual, err := transformer.Transform(context.Background(), resolver, testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}
+
+func TestNewExprTransformer(t *testing.T) {
+	var testCases = []struct {
+		description string
+		config      string
+	}{
+		{description: "missing code", config: ""},
+		{description: "unknown identifier", config: "code=foo + 1"},
+		{description: "unknown function", config: "code='exec(1)'"},
+		{description: "unterminated string", config: `code='"abc'`},
+		{description: "unbalanced parenthesis", config: "code='(1 + 2'"},
+		{description: "incomplete ternary", config: "code='input ? 1'"},
+	}
+	for _, testCase := range testCases {
+		_, err := NewExprTransformer(context.Background(), tags.Values(testCase.config), reflect.TypeOf(0), nil)
+		assert.NotNil(t, err, testCase.description)
+	}
+}
@@ -0,0 +1,285 @@
+package xform
+
+import (
+	"context"
+	"embed"
+	"encoding"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const paramTag = "param"
+
+var (
+	parametersType = reflect.TypeOf(Parameters{})
+	durationType   = reflect.TypeOf(time.Duration(0))
+)
+
+type (
+	// FuncTransformer represents typed transformer created with Func
+	FuncTransformer[In, Out, P any] struct {
+		TransformerBase
+		fn            func(ctx context.Context, input In, params P) (Out, error)
+		params        P
+		inputType     reflect.Type
+		outputType    reflect.Type
+		convertOutput bool
+	}
+
+	funcFactory[In, Out, P any] struct {
+		name string
+		fn   func(ctx context.Context, input In, params P) (Out, error)
+	}
+)
+
+// Func creates a typed transformer factory. Input is coerced to In, tag parameters are decoded into P,
+// a struct with fields matched by param tag or case-insensitive field name (i.e. `param:"sep"`,`param:"pattern,required"`),
+// or Parameters. Out has to be assignable or convertible to the field type, which is checked when transformer is created.
+//
+//	registry.Register("truncate", xform.Func("truncate", func(ctx context.Context, text string, params struct{ Max int }) (string, error) {
+//		...
+//	}))
+func Func[In, Out, P any](name string, fn func(ctx context.Context, input In, params P) (Out, error)) Factory {
+	return &funcFactory[In, Out, P]{name: name, fn: fn}
+}
+
+func (f *funcFactory[In, Out, P]) Create(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (Transformer, error) {
+	outType := reflect.TypeOf((*Out)(nil)).Elem()
+	ret := &FuncTransformer[In, Out, P]{
+		fn:         f.fn,
+		inputType:  reflect.TypeOf((*In)(nil)).Elem(),
+		outputType: outType,
+	}
+	switch {
+	case outType.AssignableTo(destType):
+	case outType.Kind() == destType.Kind() && outType.ConvertibleTo(destType):
+		ret.outputType, ret.convertOutput = destType, true
+	default:
+		return nil, fmt.Errorf("%v transformer output %v is not compatible with destination type %v", f.name, outType, destType)
+	}
+	if err := decodeParameters(NewParameters(config), &ret.params); err != nil {
+		return nil, fmt.Errorf("%v transformer: %w", f.name, err)
+	}
+	ret.TransformerBase = NewTransformerBase(f.name, destType, config, embedFS)
+	return ret, nil
+}
+
+func (t *FuncTransformer[In, Out, P]) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	var typedInput In
+	if input != nil {
+		coerced, err := coerce(input, t.inputType)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if typedInput, ok = coerced.(In); !ok {
+			return nil, fmt.Errorf("cannot convert %T to %v", input, t.inputType)
+		}
+	}
+	output, err := t.fn(ctx, typedInput, t.params)
+	if err != nil {
+		return nil, err
+	}
+	if t.convertOutput {
+		return reflect.ValueOf(output).Convert(t.outputType).Interface(), nil
+	}
+	return output, nil
+}
+
+// InputType returns In type
+func (t *FuncTransformer[In, Out, P]) InputType() reflect.Type {
+	return t.inputType
+}
+
+// OutputType returns Out type, or destination type Out is converted to
+func (t *FuncTransformer[In, Out, P]) OutputType() reflect.Type {
+	return t.outputType
+}
+
+// coerce converts input to destination type: text is parsed, numbers convert without loss of value,
+// numbers and booleans format as text
+func coerce(input interface{}, destType reflect.Type) (interface{}, error) {
+	inputType := reflect.TypeOf(input)
+	if inputType.AssignableTo(destType) {
+		return input, nil
+	}
+	value := reflect.ValueOf(input)
+	switch {
+	case inputType.Kind() == reflect.String || (inputType.Kind() == reflect.Slice && inputType.Elem().Kind() == reflect.Uint8):
+		return parseText(value.Convert(reflect.TypeOf("")).String(), destType)
+	case isNumber(inputType.Kind()) && isNumber(destType.Kind()):
+		converted := value.Convert(destType)
+		if converted.Convert(inputType).Interface() != input || isNegative(value) != isNegative(converted) {
+			return nil, fmt.Errorf("cannot convert %v to %v: value out of range", input, destType)
+		}
+		return converted.Interface(), nil
+	case destType.Kind() == reflect.String && (isNumber(inputType.Kind()) || inputType.Kind() == reflect.Bool):
+		return reflect.ValueOf(fmt.Sprint(input)).Convert(destType).Interface(), nil
+	case inputType.Kind() == destType.Kind() && inputType.ConvertibleTo(destType):
+		return value.Convert(destType).Interface(), nil
+	}
+	return nil, fmt.Errorf("cannot convert %T to %v", input, destType)
+}
+
+// parseText parses text into destination type implementing encoding.TextUnmarshaler, or scalar, duration or pointer type
+func parseText(text string, destType reflect.Type) (interface{}, error) {
+	result := reflect.New(destType)
+	if unmarshaler, ok := result.Interface().(encoding.TextUnmarshaler); ok {
+		if err := unmarshaler.UnmarshalText([]byte(text)); err != nil {
+			return nil, fmt.Errorf("cannot convert %q to %v: %w", text, destType, err)
+		}
+		return result.Elem().Interface(), nil
+	}
+	if destType.Kind() == reflect.Slice || (destType.Kind() == reflect.Bool && text == "") {
+		return nil, fmt.Errorf("cannot convert %q to %v", text, destType)
+	}
+	if err := setParameter(result.Elem(), text); err != nil {
+		return nil, fmt.Errorf("cannot convert %q to %v: %w", text, destType, err)
+	}
+	return result.Elem().Interface(), nil
+}
+
+// isNumber returns true for integer and float kinds
+func isNumber(kind reflect.Kind) bool {
+	return (kind >= reflect.Int && kind <= reflect.Uint64) || kind == reflect.Float32 || kind == reflect.Float64
+}
+
+// isNegative returns true for negative number
+func isNegative(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int() < 0
+	case reflect.Float32, reflect.Float64:
+		return value.Float() < 0
+	}
+	return false
+}
+
+// decodeParameters decodes parameters into target struct fields, unknown parameters are reported
+func decodeParameters(params Parameters, target interface{}) error {
+	value := reflect.ValueOf(target).Elem()
+	if value.Type() == parametersType {
+		value.Set(reflect.ValueOf(params))
+		return nil
+	}
+	if value.Kind() != reflect.Struct {
+		return fmt.Errorf("unsupported parameters type: %v", value.Type())
+	}
+	used := map[string]bool{}
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, required := field.Name, false
+		if tag, ok := field.Tag.Lookup(paramTag); ok {
+			elements := strings.Split(tag, ",")
+			if elements[0] == "-" {
+				continue
+			}
+			if elements[0] != "" {
+				name = elements[0]
+			}
+			for _, option := range elements[1:] {
+				required = required || strings.TrimSpace(option) == "required"
+			}
+		}
+		key, ok := lookupParameter(params, name)
+		if !ok {
+			if required {
+				return fmt.Errorf("missing required parameter: %v", name)
+			}
+			continue
+		}
+		used[key] = true
+		if err := setParameter(value.Field(i), params[key]); err != nil {
+			return fmt.Errorf("invalid %v parameter: %v, %w", name, params[key], err)
+		}
+	}
+	for key := range params {
+		if !used[key] {
+			return fmt.Errorf("unknown parameter: %v", key)
+		}
+	}
+	return nil
+}
+
+// lookupParameter returns parameter key matching name, exact match takes precedence over case-insensitive one
+func lookupParameter(params Parameters, name string) (string, bool) {
+	if _, ok := params[name]; ok {
+		return name, true
+	}
+	for key := range params {
+		if strings.EqualFold(key, name) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// setParameter sets parameter text on field, slices use ; separated values
+func setParameter(field reflect.Value, text string) error {
+	if field.Type() == durationType {
+		duration, err := time.ParseDuration(text)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(duration))
+		return nil
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(text)
+	case reflect.Bool:
+		if text == "" { //flag parameter
+			field.SetBool(true)
+			return nil
+		}
+		value, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		field.SetBool(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.ParseInt(text, 0, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(value)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseUint(text, 0, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(value)
+	case reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(text, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(value)
+	case reflect.Slice:
+		items := strings.Split(text, ";")
+		slice := reflect.MakeSlice(field.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := setParameter(slice.Index(i), item); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+	case reflect.Ptr:
+		ptr := reflect.New(field.Type().Elem())
+		if err := setParameter(ptr.Elem(), text); err != nil {
+			return err
+		}
+		field.Set(ptr)
+	default:
+		return fmt.Errorf("unsupported parameter type: %v", field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package xform_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/bindly/xform/conv"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type level string
+
+type truncateParams struct {
+	Max    int    `param:"max,required"`
+	Suffix string `param:"suffix"`
+}
+
+func truncate(ctx context.Context, text string, params truncateParams) (string, error) {
+	if len(text) <= params.Max {
+		return text, nil
+	}
+	return text[:params.Max] + params.Suffix, nil
+}
+
+func TestFunc(t *testing.T) {
+	registry := xform.NewRegistry()
+	conv.Init(registry)
+	registry.Register("truncate", xform.Func("truncate", truncate))
+	registry.Register("scale", xform.Func("scale", func(ctx context.Context, value float64, params struct {
+		Factor  float64
+		Timeout time.Duration
+		Tags    []string
+		Round   bool
+	}) (float64, error) {
+		if params.Round {
+			return float64(int(value * params.Factor)), nil
+		}
+		return value * params.Factor, nil
+	}))
+	registry.Register("raw", xform.Func("raw", func(ctx context.Context, value string, params xform.Parameters) (string, error) {
+		return params.Value("prefix", "") + value, nil
+	}))
+	registry.Register("double", xform.Func("double", func(ctx context.Context, value int, params struct{}) (int, error) {
+		return value * 2, nil
+	}))
+	registry.Register("year", xform.Func("year", func(ctx context.Context, value time.Time, params struct{}) (int, error) {
+		return value.Year(), nil
+	}))
+	registry.Register("fail", xform.Func("fail", func(ctx context.Context, value int, params struct{}) (int, error) {
+		return 0, fmt.Errorf("failed: %v", value)
+	}))
+
+	var testCases = []struct {
+		description string
+		config      string
+		destType    reflect.Type
+		input       interface{}
+		expect      interface{}
+		expectErr   bool
+		expectRtErr bool
+	}{
+		{description: "typed params", config: "truncate,max=3,suffix='...'", destType: reflect.TypeOf(""), input: "abcdef", expect: "abc..."},
+		{description: "input coerced", config: "truncate,max=2", destType: reflect.TypeOf(""), input: 12345, expect: "12"},
+		{description: "named destination type", config: "truncate,max=5", destType: reflect.TypeOf(level("")), input: "debug", expect: level("debug")},
+		{description: "numeric params", config: "scale,factor=1.5,round", destType: reflect.TypeOf(float64(0)), input: "3", expect: float64(4)},
+		{description: "list and duration params", config: "scale,factor=2,tags=a;b,timeout=1s", destType: reflect.TypeOf(float64(0)), input: 2, expect: float64(4)},
+		{description: "incompatible pipeline stage", config: "bool|truncate,max=3", destType: reflect.TypeOf(""), expectErr: true},
+		{description: "typed pipeline", config: "string|truncate,max=3", destType: reflect.TypeOf(""), input: []byte("abcdef"), expect: "abc"},
+		{description: "raw parameters", config: "raw,prefix=x-", destType: reflect.TypeOf(""), input: "a", expect: "x-a"},
+		{description: "missing required parameter", config: "truncate", destType: reflect.TypeOf(""), expectErr: true},
+		{description: "unknown parameter", config: "truncate,max=1,min=1", destType: reflect.TypeOf(""), expectErr: true},
+		{description: "invalid parameter", config: "truncate,max=x", destType: reflect.TypeOf(""), expectErr: true},
+		{description: "incompatible destination", config: "truncate,max=1", destType: reflect.TypeOf(0), expectErr: true},
+		{description: "numeric text coerced", config: "double", destType: reflect.TypeOf(0), input: "21", expect: 42},
+		{description: "lossless number coerced", config: "double", destType: reflect.TypeOf(0), input: 21.0, expect: 42},
+		{description: "lossy number", config: "double", destType: reflect.TypeOf(0), input: 1.5, expectRtErr: true},
+		{description: "text unmarshaler coerced", config: "year", destType: reflect.TypeOf(0), input: "2024-03-15T10:20:30Z", expect: 2024},
+		{description: "coercion error", config: "scale,factor=2", destType: reflect.TypeOf(float64(0)), input: "abc", expectRtErr: true},
+		{description: "function error", config: "fail", destType: reflect.TypeOf(0), input: 1, expectRtErr: true},
+	}
+
+	for _, testCase := range testCases {
+		transformer, err := registry.Create(context.Background(), tags.Values(testCase.config), testCase.destType, nil)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		actual, err := transformer.Transform(context.Background(), nil, testCase.input)
+		if testCase.expectRtErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}
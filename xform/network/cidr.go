@@ -0,0 +1,89 @@
+package network
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"net"
+	"net/netip"
+	"reflect"
+)
+
+var (
+	prefixType = reflect.TypeOf(netip.Prefix{})
+	ipNetType  = reflect.TypeOf(&net.IPNet{})
+)
+
+// CIDRTransformer parses text input into netip.Prefix or *net.IPNet,
+// version parameter (4 or 6) restricts address family, masked flag clears host bits
+type CIDRTransformer struct {
+	xform.TransformerBase
+	version int
+	masked  bool
+}
+
+// Reverse returns text representation of value
+func (t *CIDRTransformer) Reverse(ctx context.Context, resolver locator.Resolver, value interface{}) (interface{}, error) {
+	return reverse(value)
+}
+
+func (t *CIDRTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	if input == nil || isDestType(input, t.DestType()) {
+		return input, nil
+	}
+	text, err := asString("cidr", input)
+	if err != nil {
+		return nil, err
+	}
+	if text == "" {
+		return reflect.Zero(t.DestType()).Interface(), nil
+	}
+	prefix, err := netip.ParsePrefix(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cidr: %q", text)
+	}
+	if err = checkVersion(prefix.Addr(), t.version); err != nil {
+		return nil, err
+	}
+	if t.masked {
+		prefix = prefix.Masked()
+	}
+	if t.DestType() == prefixType {
+		return prefix, nil
+	}
+	_, ipNet, err := net.ParseCIDR(prefix.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid cidr: %q", text)
+	}
+	if !t.masked {
+		ipNet.IP = net.IP(prefix.Addr().AsSlice())
+	}
+	return ipNet, nil
+}
+
+// NewCIDRTransformer creates a new cidr transformer
+func NewCIDRTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	if destType.Kind() == reflect.Interface {
+		destType = prefixType
+	}
+	if destType != prefixType && destType != ipNetType {
+		return nil, fmt.Errorf("CIDRTransformer can only be used with netip.Prefix or *net.IPNet destination type, got %v", destType)
+	}
+	params := xform.NewParameters(config)
+	version, err := parseVersion(params)
+	if err != nil {
+		return nil, err
+	}
+	masked, err := params.Bool("masked")
+	if err != nil {
+		return nil, err
+	}
+	return &CIDRTransformer{
+		TransformerBase: xform.NewTransformerBase("cidr", destType, config, embedFS),
+		version:         version,
+		masked:          masked,
+	}, nil
+}
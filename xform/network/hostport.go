@@ -0,0 +1,128 @@
+package network
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// HostPort represents parsed host and port pair
+type HostPort struct {
+	Host string
+	Port int
+}
+
+// String returns host:port representation
+func (h HostPort) String() string {
+	return net.JoinHostPort(h.Host, strconv.Itoa(h.Port))
+}
+
+var hostPortType = reflect.TypeOf(HostPort{})
+
+// HostPortTransformer parses text input into HostPort, *HostPort or normalized host:port string,
+// host and port parameters define defaults
+type HostPortTransformer struct {
+	xform.TransformerBase
+	host string
+	port string
+}
+
+// Reverse returns text representation of value
+func (t *HostPortTransformer) Reverse(ctx context.Context, resolver locator.Resolver, value interface{}) (interface{}, error) {
+	return reverse(value)
+}
+
+func (t *HostPortTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	if input == nil || (isDestType(input, t.DestType()) && t.DestType().Kind() != reflect.String) {
+		return input, nil
+	}
+	text, err := asString("hostport", input)
+	if err != nil {
+		return nil, err
+	}
+	host, port, err := t.split(text)
+	if err != nil {
+		return nil, err
+	}
+	if host == "" {
+		return nil, fmt.Errorf("invalid host:port %q: host is required", text)
+	}
+	if port == "" {
+		return nil, fmt.Errorf("invalid host:port %q: port is required", text)
+	}
+	if err = validatePort(port); err != nil {
+		return nil, fmt.Errorf("invalid host:port %q: %w", text, err)
+	}
+	portNumber, _ := strconv.Atoi(port)
+	result := HostPort{Host: host, Port: portNumber}
+	switch t.DestType() {
+	case hostPortType:
+		return result, nil
+	case reflect.PtrTo(hostPortType):
+		return &result, nil
+	}
+	return reflect.ValueOf(result.String()).Convert(t.DestType()).Interface(), nil
+}
+
+func (t *HostPortTransformer) split(text string) (string, string, error) {
+	if text == "" {
+		return t.host, t.port, nil
+	}
+	if !hasPort(text) {
+		return strings.TrimSuffix(strings.TrimPrefix(text, "["), "]"), t.port, nil
+	}
+	host, port, err := net.SplitHostPort(text)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid host:port %q: %w", text, err)
+	}
+	if host == "" {
+		host = t.host
+	}
+	return host, port, nil
+}
+
+// hasPort returns true if text ends with :port, IP address without brackets (i.e. ::1) has no port
+func hasPort(text string) bool {
+	if net.ParseIP(text) != nil {
+		return false
+	}
+	colon := strings.LastIndex(text, ":")
+	return colon != -1 && colon > strings.LastIndex(text, "]")
+}
+
+func validatePort(port string) error {
+	value, err := strconv.Atoi(port)
+	if err != nil || value < 1 || value > 65535 {
+		return fmt.Errorf("invalid port: %v", port)
+	}
+	return nil
+}
+
+// NewHostPortTransformer creates a new host:port transformer
+func NewHostPortTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	if destType.Kind() == reflect.Interface {
+		destType = hostPortType
+	}
+	if destType != hostPortType && destType != reflect.PtrTo(hostPortType) && destType.Kind() != reflect.String {
+		return nil, fmt.Errorf("HostPortTransformer can only be used with HostPort, *HostPort or string destination type, got %v", destType)
+	}
+	params := xform.NewParameters(config)
+	port := params.Value("port", "")
+	if port != "" {
+		if err := validatePort(port); err != nil {
+			return nil, err
+		}
+	}
+	return &HostPortTransformer{
+		TransformerBase: xform.NewTransformerBase("hostport", destType, config, embedFS),
+		host:            params.Value("host", ""),
+		port:            port,
+	}, nil
+}
@@ -0,0 +1,11 @@
+package network
+
+import "github.com/viant/bindly/xform"
+
+// Init standard network transformers
+func Init(registry *xform.Registry) {
+	registry.Register("url", xform.NewTransformerFactory("url", NewURLTransformer))
+	registry.Register("ip", xform.NewTransformerFactory("ip", NewIPTransformer))
+	registry.Register("cidr", xform.NewTransformerFactory("cidr", NewCIDRTransformer))
+	registry.Register("hostport", xform.NewTransformerFactory("hostport", NewHostPortTransformer))
+}
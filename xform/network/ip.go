@@ -0,0 +1,96 @@
+package network
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"net"
+	"net/netip"
+	"reflect"
+)
+
+var (
+	ipType   = reflect.TypeOf(net.IP{})
+	addrType = reflect.TypeOf(netip.Addr{})
+)
+
+// IPTransformer parses text input into net.IP or netip.Addr, version parameter (4 or 6) restricts address family
+type IPTransformer struct {
+	xform.TransformerBase
+	version int
+}
+
+// Reverse returns text representation of value
+func (t *IPTransformer) Reverse(ctx context.Context, resolver locator.Resolver, value interface{}) (interface{}, error) {
+	return reverse(value)
+}
+
+func (t *IPTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	if input == nil || isDestType(input, t.DestType()) {
+		return input, nil
+	}
+	text, err := asString("ip", input)
+	if err != nil {
+		return nil, err
+	}
+	if text == "" {
+		return reflect.Zero(t.DestType()).Interface(), nil
+	}
+	addr, err := netip.ParseAddr(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ip address: %q", text)
+	}
+	if err = checkVersion(addr, t.version); err != nil {
+		return nil, err
+	}
+	if t.DestType() == addrType {
+		return addr, nil
+	}
+	return net.IP(addr.AsSlice()), nil
+}
+
+func checkVersion(addr netip.Addr, version int) error {
+	switch version {
+	case 4:
+		if !addr.Unmap().Is4() {
+			return fmt.Errorf("invalid ip address %v: expected IPv4", addr)
+		}
+	case 6:
+		if !addr.Is6() || addr.Is4In6() {
+			return fmt.Errorf("invalid ip address %v: expected IPv6", addr)
+		}
+	}
+	return nil
+}
+
+func parseVersion(params xform.Parameters) (int, error) {
+	version, err := params.Int("version", 0)
+	if err != nil {
+		return 0, err
+	}
+	if version != 0 && version != 4 && version != 6 {
+		return 0, fmt.Errorf("invalid version parameter: %v, expected 4 or 6", version)
+	}
+	return version, nil
+}
+
+// NewIPTransformer creates a new ip transformer
+func NewIPTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	if destType.Kind() == reflect.Interface {
+		destType = addrType
+	}
+	if destType != ipType && destType != addrType {
+		return nil, fmt.Errorf("IPTransformer can only be used with net.IP or netip.Addr destination type, got %v", destType)
+	}
+	version, err := parseVersion(xform.NewParameters(config))
+	if err != nil {
+		return nil, err
+	}
+	return &IPTransformer{
+		TransformerBase: xform.NewTransformerBase("ip", destType, config, embedFS),
+		version:         version,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package network
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// asString returns trimmed text input
+func asString(name string, input interface{}) (string, error) {
+	switch actual := input.(type) {
+	case string:
+		return strings.TrimSpace(actual), nil
+	case []byte:
+		return strings.TrimSpace(string(actual)), nil
+	case fmt.Stringer:
+		return strings.TrimSpace(actual.String()), nil
+	}
+	return "", fmt.Errorf("%v transformer expected string input, but had %T", name, input)
+}
+
+// reverse returns text representation of parsed network value
+func reverse(value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	rValue := reflect.ValueOf(value)
+	if rValue.Kind() == reflect.Ptr && rValue.IsNil() {
+		return nil, nil
+	}
+	if stringer, ok := value.(fmt.Stringer); ok {
+		return stringer.String(), nil
+	}
+	if rValue.Kind() != reflect.Ptr { //i.e. url.URL defines String on pointer receiver
+		ptr := reflect.New(rValue.Type())
+		ptr.Elem().Set(rValue)
+		if stringer, ok := ptr.Interface().(fmt.Stringer); ok {
+			return stringer.String(), nil
+		}
+	}
+	if rValue.Kind() == reflect.String {
+		return rValue.String(), nil
+	}
+	return nil, fmt.Errorf("cannot reverse %T as text", value)
+}
+
+// isDestType returns true if input already has destination type
+func isDestType(input interface{}, destType reflect.Type) bool {
+	return input != nil && reflect.TypeOf(input) == destType
+}
@@ -0,0 +1,73 @@
+package network_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/bindly/xform/network"
+	"github.com/viant/tagly/tags"
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestNetworkTransformers(t *testing.T) {
+	registry := xform.NewRegistry()
+	network.Init(registry)
+
+	var testCases = []struct {
+		description string
+		config      string
+		destType    reflect.Type
+		input       interface{}
+		expect      interface{}
+		expectErr   bool
+	}{
+		{description: "url", config: "url", destType: reflect.TypeOf(&url.URL{}), input: "https://example.com/v1", expect: &url.URL{Scheme: "https", Host: "example.com", Path: "/v1"}},
+		{description: "url defaults", config: "url,scheme=https,port=8443", destType: reflect.TypeOf(url.URL{}), input: "example.com/v1", expect: url.URL{Scheme: "https", Host: "example.com:8443", Path: "/v1"}},
+		{description: "url missing host", config: "url", destType: reflect.TypeOf(&url.URL{}), input: "/v1", expectErr: true},
+		{description: "url scheme not allowed", config: "url,schemes=https", destType: reflect.TypeOf(&url.URL{}), input: "ftp://example.com", expectErr: true},
+		{description: "ip addr", config: "ip", destType: reflect.TypeOf(netip.Addr{}), input: " 10.0.0.1 ", expect: netip.MustParseAddr("10.0.0.1")},
+		{description: "net ip", config: "ip", destType: reflect.TypeOf(net.IP{}), input: []byte("::1"), expect: net.IP(netip.MustParseAddr("::1").AsSlice())},
+		{description: "ip version", config: "ip,version=4", destType: reflect.TypeOf(netip.Addr{}), input: "::1", expectErr: true},
+		{description: "ip invalid", config: "ip", destType: reflect.TypeOf(netip.Addr{}), input: "10.0.0", expectErr: true},
+		{description: "cidr", config: "cidr", destType: reflect.TypeOf(netip.Prefix{}), input: "10.1.2.3/8", expect: netip.MustParsePrefix("10.1.2.3/8")},
+		{description: "cidr masked", config: "cidr,masked", destType: reflect.TypeOf(netip.Prefix{}), input: "10.1.2.3/8", expect: netip.MustParsePrefix("10.0.0.0/8")},
+		{description: "ip net", config: "cidr,masked", destType: reflect.TypeOf(&net.IPNet{}), input: "192.168.1.7/24", expect: &net.IPNet{IP: net.IP{192, 168, 1, 0}, Mask: net.CIDRMask(24, 32)}},
+		{description: "cidr invalid", config: "cidr", destType: reflect.TypeOf(netip.Prefix{}), input: "10.0.0.1", expectErr: true},
+		{description: "hostport", config: "hostport", destType: reflect.TypeOf(network.HostPort{}), input: "db:5432", expect: network.HostPort{Host: "db", Port: 5432}},
+		{description: "hostport defaults", config: "hostport,port=5432", destType: reflect.TypeOf(&network.HostPort{}), input: "[::1]", expect: &network.HostPort{Host: "::1", Port: 5432}},
+		{description: "hostport default host", config: "hostport,host=localhost", destType: reflect.TypeOf(""), input: ":8080", expect: "localhost:8080"},
+		{description: "hostport IPv6 default port", config: "hostport,port=5432", destType: reflect.TypeOf(network.HostPort{}), input: "::1", expect: network.HostPort{Host: "::1", Port: 5432}},
+		{description: "hostport IPv6 with port", config: "hostport", destType: reflect.TypeOf(""), input: "[::1]:8080", expect: "[::1]:8080"},
+		{description: "hostport IPv6 missing port", config: "hostport", destType: reflect.TypeOf(network.HostPort{}), input: "fe80::1", expectErr: true},
+		{description: "hostport invalid brackets", config: "hostport,port=5432", destType: reflect.TypeOf(network.HostPort{}), input: "[::1", expectErr: true},
+		{description: "hostport missing port", config: "hostport", destType: reflect.TypeOf(network.HostPort{}), input: "db", expectErr: true},
+		{description: "hostport invalid port", config: "hostport", destType: reflect.TypeOf(network.HostPort{}), input: "db:70000", expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		transformer, err := registry.Create(context.Background(), tags.Values(testCase.config), testCase.destType, nil)
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		actual, err := transformer.Transform(context.Background(), nil, testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}
+
+func TestNetworkTransformers_DestType(t *testing.T) {
+	registry := xform.NewRegistry()
+	network.Init(registry)
+	for _, config := range []string{"url", "ip", "cidr", "hostport"} {
+		_, err := registry.Create(context.Background(), tags.Values(config), reflect.TypeOf(0), nil)
+		assert.NotNil(t, err, config)
+	}
+}
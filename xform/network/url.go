@@ -0,0 +1,98 @@
+package network
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"net"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+var urlType = reflect.TypeOf(url.URL{})
+
+// URLTransformer parses text input into url.URL or *url.URL,
+// scheme and port parameters define defaults, schemes parameter restricts allowed schemes
+type URLTransformer struct {
+	xform.TransformerBase
+	scheme  string
+	port    string
+	schemes []string
+}
+
+// Reverse returns text representation of value
+func (t *URLTransformer) Reverse(ctx context.Context, resolver locator.Resolver, value interface{}) (interface{}, error) {
+	return reverse(value)
+}
+
+func (t *URLTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	if input == nil || isDestType(input, t.DestType()) {
+		return input, nil
+	}
+	text, err := asString("url", input)
+	if err != nil {
+		return nil, err
+	}
+	if text == "" {
+		return reflect.Zero(t.DestType()).Interface(), nil
+	}
+	if t.scheme != "" && !strings.Contains(text, "://") {
+		text = t.scheme + "://" + text
+	}
+	URL, err := url.Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url: %w", err)
+	}
+	if URL.Scheme == "" || URL.Host == "" {
+		return nil, fmt.Errorf("invalid url %q: scheme and host are required", text)
+	}
+	if len(t.schemes) > 0 && !contains(t.schemes, URL.Scheme) {
+		return nil, fmt.Errorf("invalid url %q: scheme %v is not one of: %v", text, URL.Scheme, strings.Join(t.schemes, ", "))
+	}
+	if t.port != "" && URL.Port() == "" {
+		URL.Host = net.JoinHostPort(URL.Hostname(), t.port)
+	}
+	if t.DestType().Kind() == reflect.Ptr {
+		return URL, nil
+	}
+	return *URL, nil
+}
+
+func contains(candidates []string, value string) bool {
+	for _, candidate := range candidates {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewURLTransformer creates a new url transformer
+func NewURLTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	if destType.Kind() == reflect.Interface {
+		destType = reflect.PtrTo(urlType)
+	}
+	if destType != urlType && destType != reflect.PtrTo(urlType) {
+		return nil, fmt.Errorf("URLTransformer can only be used with url.URL or *url.URL destination type, got %v", destType)
+	}
+	params := xform.NewParameters(config)
+	port := params.Value("port", "")
+	if port != "" {
+		if err := validatePort(port); err != nil {
+			return nil, err
+		}
+	}
+	ret := &URLTransformer{
+		TransformerBase: xform.NewTransformerBase("url", destType, config, embedFS),
+		scheme:          params.Value("scheme", ""),
+		port:            port,
+	}
+	if schemes := params.Value("schemes", ""); schemes != "" {
+		ret.schemes = strings.Split(schemes, ";")
+	}
+	return ret, nil
+}
@@ -0,0 +1,73 @@
+package xform
+
+import (
+	"fmt"
+	"github.com/viant/tagly/tags"
+	"strconv"
+	"strings"
+)
+
+// Parameters represents transformer parameters, i.e. layout=2006-01-02,tz=UTC
+type Parameters map[string]string
+
+// Lookup returns parameter value
+func (p Parameters) Lookup(name string) (string, bool) {
+	value, ok := p[name]
+	return value, ok
+}
+
+// Has returns true if parameter is defined, i.e. flag parameter like strict
+func (p Parameters) Has(name string) bool {
+	_, ok := p[name]
+	return ok
+}
+
+// Value returns parameter value or default value if parameter is not defined
+func (p Parameters) Value(name string, defaultValue string) string {
+	if value, ok := p[name]; ok && value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// Int returns int parameter value or default value if parameter is not defined
+func (p Parameters) Int(name string, defaultValue int) (int, error) {
+	value, ok := p[name]
+	if !ok || value == "" {
+		return defaultValue, nil
+	}
+	result, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %v parameter: %v, %w", name, value, err)
+	}
+	return result, nil
+}
+
+// Bool returns bool parameter value, parameter defined without value is considered true
+func (p Parameters) Bool(name string) (bool, error) {
+	value, ok := p[name]
+	if !ok {
+		return false, nil
+	}
+	if value == "" {
+		return true, nil
+	}
+	result, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %v parameter: %v, %w", name, value, err)
+	}
+	return result, nil
+}
+
+// NewParameters creates parameters from transformer tag values, single-quoted values are unquoted
+func NewParameters(config tags.Values) Parameters {
+	var result = Parameters{}
+	_ = config.MatchPairs(func(key, value string) error {
+		if len(value) > 1 && strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'") {
+			value = strings.ReplaceAll(value[1:len(value)-1], `\'`, `'`)
+		}
+		result[strings.TrimSpace(key)] = value
+		return nil
+	})
+	return result
+}
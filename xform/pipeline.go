@@ -0,0 +1,115 @@
+package xform
+
+import (
+	"context"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"strings"
+)
+
+// Pipeline represents transformers chain, each stage output feeds the next stage
+type Pipeline struct {
+	names  []string
+	stages []Transformer
+}
+
+func (p *Pipeline) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	var err error
+	for i, stage := range p.stages {
+		if input, err = stage.Transform(ctx, resolver, input); err != nil {
+			return nil, fmt.Errorf("pipeline stage %v failed: %w", p.names[i], err)
+		}
+	}
+	return input, nil
+}
+
+// Reverse reverses stages in reverse order, stages not implementing Reverser pass value through
+func (p *Pipeline) Reverse(ctx context.Context, resolver locator.Resolver, value interface{}) (interface{}, error) {
+	var err error
+	for i := len(p.stages) - 1; i >= 0; i-- {
+		reverser, ok := p.stages[i].(Reverser)
+		if !ok {
+			continue
+		}
+		if value, err = reverser.Reverse(ctx, resolver, value); err != nil {
+			return nil, fmt.Errorf("pipeline stage %v reverse failed: %w", p.names[i], err)
+		}
+	}
+	return value, nil
+}
+
+// Stages returns pipeline stages
+func (p *Pipeline) Stages() []Transformer {
+	return p.stages
+}
+
+// InputType returns first stage input type
+func (p *Pipeline) InputType() reflect.Type {
+	return inputType(p.stages[0])
+}
+
+// OutputType returns last stage output type
+func (p *Pipeline) OutputType() reflect.Type {
+	return outputType(p.stages[len(p.stages)-1])
+}
+
+func inputType(transformer Transformer) reflect.Type {
+	if typed, ok := transformer.(Typed); ok && typed.InputType() != nil {
+		return typed.InputType()
+	}
+	return AnyType
+}
+
+func outputType(transformer Transformer) reflect.Type {
+	if typed, ok := transformer.(Typed); ok && typed.OutputType() != nil {
+		return typed.OutputType()
+	}
+	return AnyType
+}
+
+// isCompatible returns true if output type can feed input type
+func isCompatible(output, input reflect.Type) bool {
+	if input.Kind() == reflect.Interface {
+		return output.Kind() == reflect.Interface || output.Implements(input)
+	}
+	if output.Kind() == reflect.Interface { //checked at runtime
+		return true
+	}
+	return output.AssignableTo(input) || output.ConvertibleTo(input)
+}
+
+// SplitPipeline splits transformer tag into stages separated by |, quoted ('...'), {...} and (...) sections are not split
+func SplitPipeline(config tags.Values) []tags.Values {
+	var result []tags.Values
+	text := string(config)
+	depth, quoted, start := 0, false, 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+		case '\'':
+			quoted = !quoted
+		case '{', '(':
+			if !quoted {
+				depth++
+			}
+		case '}', ')':
+			if !quoted && depth > 0 {
+				depth--
+			}
+		case '|':
+			if !quoted && depth == 0 {
+				result = append(result, tags.Values(strings.TrimSpace(text[start:i])))
+				start = i + 1
+			}
+		}
+	}
+	return append(result, tags.Values(strings.TrimSpace(text[start:])))
+}
+
+// NewPipeline creates a pipeline for supplied stages
+func NewPipeline(names []string, stages []Transformer) *Pipeline {
+	return &Pipeline{names: names, stages: stages}
+}
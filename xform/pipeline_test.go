@@ -0,0 +1,115 @@
+package xform_test
+
+import (
+	"context"
+	"embed"
+	"github.com/stretchr/testify/assert"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/bindly/xform/conv"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// doubleTransformer doubles int input
+type doubleTransformer struct {
+	xform.TransformerBase
+}
+
+func (t *doubleTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	return input.(int) * 2, nil
+}
+
+func (t *doubleTransformer) InputType() reflect.Type {
+	return reflect.TypeOf(0)
+}
+
+func newDoubleTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	return &doubleTransformer{TransformerBase: xform.NewTransformerBase("double", destType, config, embedFS)}, nil
+}
+
+func TestRegistry_Create(t *testing.T) {
+	registry := xform.NewRegistry()
+	conv.Init(registry)
+	registry.Register("double", xform.NewTransformerFactory("double", newDoubleTransformer))
+
+	var testCases = []struct {
+		description string
+		config      string
+		destType    reflect.Type
+		input       interface{}
+		expect      interface{}
+		expectErr   bool
+	}{
+		{description: "single stage", config: "int", destType: reflect.TypeOf(int16(0)), input: "12", expect: int16(12)},
+		{description: "two stages", config: "string|int", destType: reflect.TypeOf(0), input: []byte("0x10"), expect: 16},
+		{description: "typed stage input", config: "string|int|double", destType: reflect.TypeOf(0), input: "21", expect: 42},
+		{description: "incompatible stages", config: "bool|double", destType: reflect.TypeOf(0), expectErr: true},
+		{description: "last stage checked against destination", config: "string|int", destType: reflect.TypeOf(""), expectErr: true},
+		{description: "unknown stage", config: "string|unknown", destType: reflect.TypeOf(""), expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		transformer, err := registry.Create(context.Background(), tags.Values(testCase.config), testCase.destType, nil)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		actual, err := transformer.Transform(context.Background(), nil, testCase.input)
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}
+
+func TestSplitPipeline(t *testing.T) {
+	var testCases = []struct {
+		description string
+		config      string
+		expect      []tags.Values
+	}{
+		{description: "single", config: "int", expect: []tags.Values{"int"}},
+		{description: "stages", config: "trim | lower|enum,values=a;b;c", expect: []tags.Values{"trim", "lower", "enum,values=a;b;c"}},
+		{description: "quoted", config: "regex,pattern='^(a|b)$'|string", expect: []tags.Values{"regex,pattern='^(a|b)$'", "string"}},
+		{description: "block", config: "expr,code={a || b}|bool", expect: []tags.Values{"expr,code={a || b}", "bool"}},
+	}
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expect, xform.SplitPipeline(tags.Values(testCase.config)), testCase.description)
+	}
+}
+
+func TestPipeline_Reverse(t *testing.T) {
+	registry := xform.NewRegistry()
+	conv.Init(registry)
+
+	var testCases = []struct {
+		description string
+		config      string
+		destType    reflect.Type
+		value       interface{}
+		expect      interface{}
+	}{
+		{description: "duration text", config: "duration", destType: reflect.TypeOf(time.Duration(0)), value: 90 * time.Second, expect: "1m30s"},
+		{description: "duration unit", config: "duration,unit=ms", destType: reflect.TypeOf(time.Duration(0)), value: 2 * time.Second, expect: int64(2000)},
+		{description: "time layout", config: "time,layout=DateOnly", destType: reflect.TypeOf(time.Time{}), value: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC), expect: "2024-05-06"},
+		{description: "time epoch", config: "time,unit=ms", destType: reflect.TypeOf(time.Time{}), value: time.UnixMilli(1700000000123), expect: int64(1700000000123)},
+		{description: "non reversible stage passes through", config: "string|duration", destType: reflect.TypeOf(time.Duration(0)), value: time.Minute, expect: "1m0s"},
+	}
+	for _, testCase := range testCases {
+		transformer, err := registry.Create(context.Background(), tags.Values(testCase.config), testCase.destType, nil)
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		reverser, ok := transformer.(xform.Reverser)
+		if !assert.True(t, ok, testCase.description) {
+			continue
+		}
+		actual, err := reverser.Reverse(context.Background(), nil, testCase.value)
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}
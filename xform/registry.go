@@ -1,6 +1,13 @@
 package xform
 
-import "github.com/viant/bindly/internal"
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/internal"
+	"github.com/viant/tagly/tags"
+	"reflect"
+)
 
 type Registry struct {
 	internal.Map[string, Factory]
@@ -16,6 +23,39 @@ func (r *Registry) Lookup(name string) (Factory, bool) {
 	return r.Get(name)
 }
 
+// Create creates a transformer for supplied tag value, i.e. trim|lower|enum,values=a;b;c
+// Pipeline stages are created from the last one, which is checked against destination type,
+// each preceding stage uses the following stage input type as its destination type.
+func (r *Registry) Create(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (Transformer, error) {
+	stagesConfig := SplitPipeline(config)
+	names := make([]string, len(stagesConfig))
+	stages := make([]Transformer, len(stagesConfig))
+	stageDestType := destType
+	for i := len(stagesConfig) - 1; i >= 0; i-- {
+		name, stageConfig := stagesConfig[i].Name()
+		factory, ok := r.Lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("failed to lookup transformer: %v", name)
+		}
+		transformer, err := factory.Create(ctx, stageConfig, stageDestType, embedFS)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create transformer: %v, %w", name, err)
+		}
+		if i < len(stagesConfig)-1 {
+			if output := outputType(transformer); !isCompatible(output, stageDestType) {
+				return nil, fmt.Errorf("incompatible pipeline stages: %v output %v, %v input %v", name, output, names[i+1], stageDestType)
+			}
+		}
+		names[i] = name
+		stages[i] = transformer
+		stageDestType = inputType(transformer)
+	}
+	if len(stages) == 1 {
+		return stages[0], nil
+	}
+	return NewPipeline(names, stages), nil
+}
+
 func NewRegistry() *Registry {
 	return &Registry{
 		Map: internal.NewMap[string, Factory](),
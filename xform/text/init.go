@@ -0,0 +1,17 @@
+package text
+
+import "github.com/viant/bindly/xform"
+
+// Init standard text transformers
+func Init(registry *xform.Registry) {
+	registry.Register("trim", xform.NewTransformerFactory("trim", NewTrimTransformer))
+	registry.Register("lower", xform.NewTransformerFactory("lower", NewLowerTransformer))
+	registry.Register("upper", xform.NewTransformerFactory("upper", NewUpperTransformer))
+	registry.Register("split", xform.NewTransformerFactory("split", NewSplitTransformer))
+	registry.Register("join", xform.NewTransformerFactory("join", NewJoinTransformer))
+	registry.Register("replace", xform.NewTransformerFactory("replace", NewReplaceTransformer))
+	registry.Register("base64", xform.NewTransformerFactory("base64", NewBase64Transformer))
+	registry.Register("unbase64", xform.NewTransformerFactory("unbase64", NewUnbase64Transformer))
+	registry.Register("hex", xform.NewTransformerFactory("hex", NewHexTransformer))
+	registry.Register("unhex", xform.NewTransformerFactory("unhex", NewUnhexTransformer))
+}
@@ -0,0 +1,62 @@
+package text
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"strings"
+)
+
+// JoinTransformer joins slice input elements into text
+type JoinTransformer struct {
+	xform.TransformerBase
+	separator string
+}
+
+func (t *JoinTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	text, err := join(input, t.separator)
+	if err != nil {
+		return nil, err
+	}
+	return asDestType(text, t.DestType()), nil
+}
+
+// Reverse splits text value with separator
+func (t *JoinTransformer) Reverse(ctx context.Context, resolver locator.Resolver, value interface{}) (interface{}, error) {
+	text := asString(value)
+	if text == "" {
+		return []string{}, nil
+	}
+	return strings.Split(text, t.separator), nil
+}
+
+func join(input interface{}, separator string) (string, error) {
+	if input == nil {
+		return "", nil
+	}
+	value := reflect.ValueOf(input)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return "", fmt.Errorf("join transformer expected slice input, but had %T", input)
+	}
+	items := make([]string, value.Len())
+	for i := range items {
+		items[i] = asString(value.Index(i).Interface())
+	}
+	return strings.Join(items, separator), nil
+}
+
+// NewJoinTransformer creates a new join transformer, sep parameter defines separator (, by default)
+func NewJoinTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	destType, err := textDestType("join", destType)
+	if err != nil {
+		return nil, err
+	}
+	return &JoinTransformer{
+		TransformerBase: xform.NewTransformerBase("join", destType, config, embedFS),
+		separator:       xform.NewParameters(config).Value("sep", ","),
+	}, nil
+}
@@ -0,0 +1,64 @@
+package text
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"strings"
+)
+
+var stringsType = reflect.TypeOf([]string{})
+
+// SplitTransformer splits text input into []string, element conversion (i.e. []int) is handled by binding value adjustment
+type SplitTransformer struct {
+	xform.TransformerBase
+	separator string
+	omitEmpty bool
+}
+
+func (t *SplitTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	text := strings.TrimSpace(asString(input))
+	result := []string{}
+	if text == "" {
+		return result, nil
+	}
+	for _, item := range strings.Split(text, t.separator) {
+		item = strings.TrimSpace(item)
+		if item == "" && t.omitEmpty {
+			continue
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// Reverse joins slice value elements with separator
+func (t *SplitTransformer) Reverse(ctx context.Context, resolver locator.Resolver, value interface{}) (interface{}, error) {
+	return join(value, t.separator)
+}
+
+// OutputType returns []string
+func (t *SplitTransformer) OutputType() reflect.Type {
+	return stringsType
+}
+
+// NewSplitTransformer creates a new split transformer, sep parameter defines separator (, by default)
+func NewSplitTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	if destType.Kind() != reflect.Slice && destType.Kind() != reflect.Interface {
+		return nil, fmt.Errorf("split transformer can only be used with slice destination type, got %v", destType)
+	}
+	params := xform.NewParameters(config)
+	omitEmpty, err := params.Bool("omitEmpty")
+	if err != nil {
+		return nil, err
+	}
+	return &SplitTransformer{
+		TransformerBase: xform.NewTransformerBase("split", destType, config, embedFS),
+		separator:       params.Value("sep", ","),
+		omitEmpty:       omitEmpty,
+	}, nil
+}
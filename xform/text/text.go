@@ -0,0 +1,195 @@
+package text
+
+import (
+	"context"
+	"embed"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"strings"
+)
+
+var (
+	stringType = reflect.TypeOf("")
+	bytesType  = reflect.TypeOf([]byte{})
+)
+
+// TextTransformer applies string function to stringified input
+type TextTransformer struct {
+	xform.TransformerBase
+	fn      func(text string) (string, error)
+	reverse func(text string) (string, error)
+}
+
+func (t *TextTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	text, err := t.fn(asString(input))
+	if err != nil {
+		return nil, err
+	}
+	return asDestType(text, t.DestType()), nil
+}
+
+// Reverse applies reverse string function if transformation is reversible, otherwise returns value unchanged
+func (t *TextTransformer) Reverse(ctx context.Context, resolver locator.Resolver, value interface{}) (interface{}, error) {
+	if t.reverse == nil {
+		return value, nil
+	}
+	return t.reverse(asString(value))
+}
+
+// asString returns string representation of input
+func asString(input interface{}) string {
+	switch actual := input.(type) {
+	case nil:
+		return ""
+	case string:
+		return actual
+	case []byte:
+		return string(actual)
+	case fmt.Stringer:
+		return actual.String()
+	}
+	value := reflect.ValueOf(input)
+	if value.Kind() == reflect.String {
+		return value.String()
+	}
+	return fmt.Sprintf("%v", input)
+}
+
+// asDestType converts text to string or []byte destination type
+func asDestType(text string, destType reflect.Type) interface{} {
+	if destType.Kind() == reflect.Slice {
+		return reflect.ValueOf([]byte(text)).Convert(destType).Interface()
+	}
+	return reflect.ValueOf(text).Convert(destType).Interface()
+}
+
+// textDestType returns text destination type, string for untyped destination
+func textDestType(name string, destType reflect.Type) (reflect.Type, error) {
+	switch {
+	case destType.Kind() == reflect.Interface:
+		return stringType, nil
+	case destType.Kind() == reflect.String, destType.ConvertibleTo(bytesType) && destType.Kind() == reflect.Slice:
+		return destType, nil
+	}
+	return nil, fmt.Errorf("%v transformer can only be used with string or []byte destination type, got %v", name, destType)
+}
+
+func newTextTransformer(name string, config tags.Values, destType reflect.Type, embedFS *embed.FS, fn func(text string) (string, error)) (xform.Transformer, error) {
+	return newReversibleTextTransformer(name, config, destType, embedFS, fn, nil)
+}
+
+func newReversibleTextTransformer(name string, config tags.Values, destType reflect.Type, embedFS *embed.FS, fn, reverse func(text string) (string, error)) (xform.Transformer, error) {
+	destType, err := textDestType(name, destType)
+	if err != nil {
+		return nil, err
+	}
+	return &TextTransformer{
+		TransformerBase: xform.NewTransformerBase(name, destType, config, embedFS),
+		fn:              fn,
+		reverse:         reverse,
+	}, nil
+}
+
+// NewTrimTransformer creates a new trim transformer, optional cutset parameter defines trimmed characters
+func NewTrimTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	cutset, ok := xform.NewParameters(config).Lookup("cutset")
+	return newTextTransformer("trim", config, destType, embedFS, func(text string) (string, error) {
+		if ok && cutset != "" {
+			return strings.Trim(text, cutset), nil
+		}
+		return strings.TrimSpace(text), nil
+	})
+}
+
+// NewLowerTransformer creates a new lower case transformer
+func NewLowerTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	return newTextTransformer("lower", config, destType, embedFS, func(text string) (string, error) {
+		return strings.ToLower(text), nil
+	})
+}
+
+// NewUpperTransformer creates a new upper case transformer
+func NewUpperTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	return newTextTransformer("upper", config, destType, embedFS, func(text string) (string, error) {
+		return strings.ToUpper(text), nil
+	})
+}
+
+// NewReplaceTransformer creates a new replace transformer, old and new parameters define replaced text
+func NewReplaceTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	params := xform.NewParameters(config)
+	oldText, ok := params.Lookup("old")
+	if !ok || oldText == "" {
+		return nil, fmt.Errorf("replace transformer requires old parameter")
+	}
+	newText, _ := params.Lookup("new")
+	return newTextTransformer("replace", config, destType, embedFS, func(text string) (string, error) {
+		return strings.ReplaceAll(text, oldText, newText), nil
+	})
+}
+
+// NewHexTransformer creates a new hex encoding transformer
+func NewHexTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	return newReversibleTextTransformer("hex", config, destType, embedFS, encodeHex, decodeHex)
+}
+
+// NewUnhexTransformer creates a new hex decoding transformer
+func NewUnhexTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	return newReversibleTextTransformer("unhex", config, destType, embedFS, decodeHex, encodeHex)
+}
+
+func encodeHex(text string) (string, error) {
+	return hex.EncodeToString([]byte(text)), nil
+}
+
+func decodeHex(text string) (string, error) {
+	data, err := hex.DecodeString(strings.TrimSpace(text))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode hex: %w", err)
+	}
+	return string(data), nil
+}
+
+// NewBase64Transformer creates a new base64 encoding transformer, url and raw flags select encoding variant
+func NewBase64Transformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	encode, decode := base64Codec(base64Encoding(xform.NewParameters(config)))
+	return newReversibleTextTransformer("base64", config, destType, embedFS, encode, decode)
+}
+
+// NewUnbase64Transformer creates a new base64 decoding transformer, url and raw flags select encoding variant
+func NewUnbase64Transformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	encode, decode := base64Codec(base64Encoding(xform.NewParameters(config)))
+	return newReversibleTextTransformer("unbase64", config, destType, embedFS, decode, encode)
+}
+
+func base64Codec(encoding *base64.Encoding) (encode, decode func(text string) (string, error)) {
+	encode = func(text string) (string, error) {
+		return encoding.EncodeToString([]byte(text)), nil
+	}
+	decode = func(text string) (string, error) {
+		data, err := encoding.DecodeString(strings.TrimSpace(text))
+		if err != nil {
+			return "", fmt.Errorf("failed to decode base64: %w", err)
+		}
+		return string(data), nil
+	}
+	return encode, decode
+}
+
+func base64Encoding(params xform.Parameters) *base64.Encoding {
+	isURL, isRaw := params.Has("url"), params.Has("raw")
+	switch {
+	case isURL && isRaw:
+		return base64.RawURLEncoding
+	case isURL:
+		return base64.URLEncoding
+	case isRaw:
+		return base64.RawStdEncoding
+	}
+	return base64.StdEncoding
+}
@@ -0,0 +1,60 @@
+package text_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/bindly/xform/text"
+	"github.com/viant/bindly/xform/validate"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"testing"
+)
+
+type level string
+
+func TestTextTransformers(t *testing.T) {
+	registry := xform.NewRegistry()
+	text.Init(registry)
+	validate.Init(registry)
+
+	var testCases = []struct {
+		description string
+		config      string
+		destType    reflect.Type
+		input       interface{}
+		expect      interface{}
+		expectErr   bool
+	}{
+		{description: "trim", config: "trim", destType: reflect.TypeOf(""), input: "  abc ", expect: "abc"},
+		{description: "trim cutset", config: "trim,cutset=/", destType: reflect.TypeOf(""), input: "/api/", expect: "api"},
+		{description: "lower named type", config: "lower", destType: reflect.TypeOf(level("")), input: "DEBUG", expect: level("debug")},
+		{description: "upper bytes", config: "upper", destType: reflect.TypeOf([]byte{}), input: "abc", expect: []byte("ABC")},
+		{description: "normalize and validate", config: "trim|lower|enum,values=dev;staging;prod", destType: reflect.TypeOf(""), input: " PROD ", expect: "prod"},
+		{description: "normalize and validate error", config: "trim|lower|enum,values=dev;staging;prod", destType: reflect.TypeOf(""), input: "qa", expectErr: true},
+		{description: "split", config: "split", destType: reflect.TypeOf([]string{}), input: "a, b,c", expect: []string{"a", "b", "c"}},
+		{description: "split separator", config: "split,sep=';',omitEmpty", destType: reflect.TypeOf([]string{}), input: "a;;b", expect: []string{"a", "b"}},
+		{description: "split into ints", config: "split", destType: reflect.TypeOf([]int{}), input: "1,2", expect: []string{"1", "2"}},
+		{description: "join", config: "join,sep=' '", destType: reflect.TypeOf(""), input: []int{1, 2, 3}, expect: "1 2 3"},
+		{description: "replace", config: "replace,old=-,new=_", destType: reflect.TypeOf(""), input: "a-b-c", expect: "a_b_c"},
+		{description: "base64", config: "base64", destType: reflect.TypeOf(""), input: "hello", expect: "aGVsbG8="},
+		{description: "unbase64", config: "unbase64,url,raw", destType: reflect.TypeOf([]byte{}), input: "aGVsbG8", expect: []byte("hello")},
+		{description: "unbase64 error", config: "unbase64", destType: reflect.TypeOf(""), input: "%%%", expectErr: true},
+		{description: "hex", config: "hex", destType: reflect.TypeOf(""), input: []byte{0xca, 0xfe}, expect: "cafe"},
+		{description: "unhex", config: "unhex", destType: reflect.TypeOf([]byte{}), input: "cafe", expect: []byte{0xca, 0xfe}},
+	}
+
+	for _, testCase := range testCases {
+		transformer, err := registry.Create(context.Background(), tags.Values(testCase.config), testCase.destType, nil)
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		actual, err := transformer.Transform(context.Background(), nil, testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}
@@ -0,0 +1,8 @@
+package tmpl
+
+import "github.com/viant/bindly/xform"
+
+// Init standard template transformers
+func Init(registry *xform.Registry) {
+	registry.Register("template", xform.NewTransformerFactory("template", NewTemplateTransformer))
+}
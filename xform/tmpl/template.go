@@ -0,0 +1,77 @@
+package tmpl
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/state"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"path"
+	"reflect"
+	"text/template"
+)
+
+// TemplateTransformer renders text/template loaded from embedded file system with input as dot,
+// resolve function returns other location value, i.e. {{resolve "setting:table"}}
+type TemplateTransformer struct {
+	xform.TransformerBase
+	template *template.Template
+}
+
+func (t *TemplateTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	aTemplate, err := t.template.Clone()
+	if err != nil {
+		return nil, err
+	}
+	aTemplate.Funcs(template.FuncMap{"resolve": resolveFunc(ctx, resolver)})
+	buffer := bytes.Buffer{}
+	if err = aTemplate.Execute(&buffer, input); err != nil {
+		return nil, fmt.Errorf("failed to render template %v: %w", aTemplate.Name(), err)
+	}
+	if t.DestType().Kind() == reflect.Slice {
+		return buffer.Bytes(), nil
+	}
+	return reflect.ValueOf(buffer.String()).Convert(t.DestType()).Interface(), nil
+}
+
+func resolveFunc(ctx context.Context, resolver locator.Resolver) func(location string) (interface{}, error) {
+	return func(location string) (interface{}, error) {
+		if resolver == nil {
+			return nil, fmt.Errorf("failed to resolve %v: resolver was nil", location)
+		}
+		value, _, err := resolver.Value(ctx, state.ParseLocation(location))
+		return value, err
+	}
+}
+
+// NewTemplateTransformer creates a new template transformer, uri parameter defines template location in embedded file system
+func NewTemplateTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	if destType.Kind() == reflect.Interface {
+		destType = reflect.TypeOf("")
+	}
+	if destType.Kind() != reflect.String && destType != reflect.TypeOf([]byte{}) {
+		return nil, fmt.Errorf("TemplateTransformer can only be used with string or []byte destination type, got %v", destType)
+	}
+	URI, ok := xform.NewParameters(config).Lookup("uri")
+	if !ok || URI == "" {
+		return nil, fmt.Errorf("TemplateTransformer requires uri parameter")
+	}
+	if embedFS == nil {
+		return nil, fmt.Errorf("TemplateTransformer requires embedded file system to load %v", URI)
+	}
+	content, err := embedFS.ReadFile(URI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load template %v: %w", URI, err)
+	}
+	aTemplate, err := template.New(path.Base(URI)).Funcs(template.FuncMap{"resolve": resolveFunc(ctx, nil)}).Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %v: %w", URI, err)
+	}
+	return &TemplateTransformer{
+		TransformerBase: xform.NewTransformerBase("template", destType, config, embedFS),
+		template:        aTemplate,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package tmpl
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/viant/bindly/state"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"testing"
+)
+
+//go:embed testdata/*
+var testFS embed.FS
+
+type testResolver map[string]interface{}
+
+func (r testResolver) Value(ctx context.Context, location *state.Location) (interface{}, bool, error) {
+	value, ok := r[location.String()]
+	if !ok {
+		return nil, false, fmt.Errorf("unknown location: %v", location)
+	}
+	return value, true, nil
+}
+
+func TestTemplateTransformer_Transform(t *testing.T) {
+	var testCases = []struct {
+		description string
+		config      string
+		embedFS     *embed.FS
+		destType    reflect.Type
+		input       interface{}
+		expect      interface{}
+		expectErr   bool
+	}{
+		{description: "render string", config: "uri=testdata/query.sql", embedFS: &testFS, destType: reflect.TypeOf(""), input: 101, expect: "SELECT * FROM users WHERE ID = 101"},
+		{description: "render bytes", config: "uri=testdata/query.sql", embedFS: &testFS, destType: reflect.TypeOf([]byte{}), input: 1, expect: []byte("SELECT * FROM users WHERE ID = 1")},
+		{description: "missing template", config: "uri=testdata/missing.sql", embedFS: &testFS, destType: reflect.TypeOf(""), expectErr: true},
+		{description: "missing fs", config: "uri=testdata/query.sql", destType: reflect.TypeOf(""), expectErr: true},
+		{description: "invalid destination", config: "uri=testdata/query.sql", embedFS: &testFS, destType: reflect.TypeOf(0), expectErr: true},
+	}
+
+	resolver := testResolver{"setting:table": "users"}
+	for _, testCase := range testCases {
+		transformer, err := NewTemplateTransformer(context.Background(), tags.Values(testCase.config), testCase.destType, testCase.embedFS)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		actual, err := transformer.Transform(context.Background(), resolver, testCase.input)
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}
@@ -0,0 +1 @@
+SELECT * FROM {{resolve "setting:table"}} WHERE ID = {{.}}
\ No newline at end of file
@@ -3,8 +3,23 @@ package xform
 import (
 	"context"
 	"github.com/viant/bindly/locator"
+	"reflect"
 )
 
+// AnyType represents interface{} type, used as destination type of untyped pipeline stages
+var AnyType = reflect.TypeOf((*interface{})(nil)).Elem()
+
 type Transformer interface {
 	Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error)
 }
+
+// Reverser represents transformer able to reverse its transformation, used to write values back to their source location
+type Reverser interface {
+	Reverse(ctx context.Context, resolver locator.Resolver, value interface{}) (interface{}, error)
+}
+
+// Typed represents transformer declaring its input and output types, used to check pipeline stages
+type Typed interface {
+	InputType() reflect.Type
+	OutputType() reflect.Type
+}
@@ -0,0 +1,59 @@
+package validate
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"strings"
+)
+
+// EnumTransformer validates that input is one of allowed values
+type EnumTransformer struct {
+	validatorBase
+	values     []string
+	ignoreCase bool
+}
+
+func (t *EnumTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	if input == nil {
+		return input, nil
+	}
+	err := each(input, func(item interface{}) error {
+		text, ok := asString(item)
+		if !ok {
+			text = fmt.Sprintf("%v", item)
+		}
+		for _, candidate := range t.values {
+			if candidate == text || (t.ignoreCase && strings.EqualFold(candidate, text)) {
+				return nil
+			}
+		}
+		return fmt.Errorf("value %q is not one of: %v", text, strings.Join(t.values, ", "))
+	})
+	return input, err
+}
+
+// NewEnumTransformer creates a new enum transformer, values parameter defines allowed values separated by ;
+func NewEnumTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	params := xform.NewParameters(config)
+	values, ok := params.Lookup("values")
+	if !ok || values == "" {
+		return nil, fmt.Errorf("EnumTransformer requires values parameter")
+	}
+	ignoreCase, err := params.Bool("ignoreCase")
+	if err != nil {
+		return nil, err
+	}
+	ret := &EnumTransformer{
+		validatorBase: validatorBase{TransformerBase: xform.NewTransformerBase("enum", destType, config, embedFS)},
+		ignoreCase:    ignoreCase,
+	}
+	for _, value := range strings.Split(values, ";") {
+		ret.values = append(ret.values, strings.TrimSpace(value))
+	}
+	return ret, nil
+}
@@ -0,0 +1,12 @@
+package validate
+
+import "github.com/viant/bindly/xform"
+
+// Init standard validation transformers
+func Init(registry *xform.Registry) {
+	registry.Register("range", xform.NewTransformerFactory("range", NewRangeTransformer))
+	registry.Register("regex", xform.NewTransformerFactory("regex", NewRegexTransformer))
+	registry.Register("enum", xform.NewTransformerFactory("enum", NewEnumTransformer))
+	registry.Register("len", xform.NewTransformerFactory("len", NewLenTransformer))
+	registry.Register("notEmpty", xform.NewTransformerFactory("notEmpty", NewNotEmptyTransformer))
+}
@@ -0,0 +1,73 @@
+package validate
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"unicode/utf8"
+)
+
+// LenTransformer validates that string, slice or map input length is within min and max (inclusive)
+type LenTransformer struct {
+	validatorBase
+	min int
+	max int
+}
+
+func (t *LenTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	length, err := length(input)
+	if err != nil {
+		return nil, err
+	}
+	if length < t.min {
+		return nil, fmt.Errorf("length %v is less than min %v", length, t.min)
+	}
+	if t.max >= 0 && length > t.max {
+		return nil, fmt.Errorf("length %v is greater than max %v", length, t.max)
+	}
+	return input, nil
+}
+
+func length(input interface{}) (int, error) {
+	if input == nil {
+		return 0, nil
+	}
+	if text, ok := asString(input); ok {
+		return utf8.RuneCountInString(text), nil
+	}
+	value := reflect.ValueOf(input)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return value.Len(), nil
+	case reflect.Ptr:
+		if value.IsNil() {
+			return 0, nil
+		}
+		return length(value.Elem().Interface())
+	}
+	return 0, fmt.Errorf("cannot take length of %T", input)
+}
+
+// NewLenTransformer creates a new length transformer, min and max parameters define inclusive bounds
+func NewLenTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	params := xform.NewParameters(config)
+	if !params.Has("min") && !params.Has("max") {
+		return nil, fmt.Errorf("LenTransformer requires min or max parameter")
+	}
+	ret := &LenTransformer{validatorBase: validatorBase{TransformerBase: xform.NewTransformerBase("len", destType, config, embedFS)}}
+	var err error
+	if ret.min, err = params.Int("min", 0); err != nil {
+		return nil, err
+	}
+	if ret.max, err = params.Int("max", -1); err != nil {
+		return nil, err
+	}
+	if ret.max >= 0 && ret.min > ret.max {
+		return nil, fmt.Errorf("invalid length: min %v is greater than max %v", ret.min, ret.max)
+	}
+	return ret, nil
+}
@@ -0,0 +1,48 @@
+package validate
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"strings"
+)
+
+// NotEmptyTransformer validates that input is not nil, blank string, or empty slice or map
+type NotEmptyTransformer struct {
+	validatorBase
+}
+
+func (t *NotEmptyTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	if input == nil {
+		return nil, fmt.Errorf("value was empty")
+	}
+	if text, ok := asString(input); ok {
+		if strings.TrimSpace(text) == "" {
+			return nil, fmt.Errorf("value was empty")
+		}
+		return input, nil
+	}
+	value := reflect.ValueOf(input)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array:
+		if value.Len() == 0 {
+			return nil, fmt.Errorf("value was empty")
+		}
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil, fmt.Errorf("value was empty")
+		}
+	}
+	return input, nil
+}
+
+// NewNotEmptyTransformer creates a new not empty transformer
+func NewNotEmptyTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	return &NotEmptyTransformer{
+		validatorBase: validatorBase{TransformerBase: xform.NewTransformerBase("notEmpty", destType, config, embedFS)},
+	}, nil
+}
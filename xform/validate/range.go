@@ -0,0 +1,88 @@
+package validate
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/bindly/xform/conv"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"time"
+)
+
+var float64Type = reflect.TypeOf(float64(0))
+
+// RangeTransformer validates that numeric or duration input is within min and max (inclusive)
+type RangeTransformer struct {
+	validatorBase
+	min *float64
+	max *float64
+}
+
+func (t *RangeTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	if input == nil {
+		return input, nil
+	}
+	err := each(input, func(item interface{}) error {
+		value, err := number(item)
+		if err != nil {
+			return err
+		}
+		if (t.min != nil && value < *t.min) || (t.max != nil && value > *t.max) {
+			return fmt.Errorf("value %v is out of range %v", item, t.describe())
+		}
+		return nil
+	})
+	return input, err
+}
+
+func (t *RangeTransformer) describe() string {
+	lower, upper := "-inf", "+inf"
+	if t.min != nil {
+		lower = fmt.Sprintf("%v", *t.min)
+	}
+	if t.max != nil {
+		upper = fmt.Sprintf("%v", *t.max)
+	}
+	return "[" + lower + ", " + upper + "]"
+}
+
+// number converts input to float64, duration strings are converted to nanoseconds
+func number(input interface{}) (float64, error) {
+	value, err := conv.Number(input, float64Type)
+	if err == nil {
+		return value.(float64), nil
+	}
+	if text, ok := input.(string); ok {
+		if duration, dErr := time.ParseDuration(text); dErr == nil {
+			return float64(duration), nil
+		}
+	}
+	return 0, err
+}
+
+// NewRangeTransformer creates a new range transformer, min and max parameters define inclusive bounds
+func NewRangeTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	params := xform.NewParameters(config)
+	ret := &RangeTransformer{validatorBase: validatorBase{TransformerBase: xform.NewTransformerBase("range", destType, config, embedFS)}}
+	for name, bound := range map[string]**float64{"min": &ret.min, "max": &ret.max} {
+		text, ok := params.Lookup(name)
+		if !ok || text == "" {
+			continue
+		}
+		value, err := number(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %v parameter: %v, %w", name, text, err)
+		}
+		*bound = &value
+	}
+	if ret.min == nil && ret.max == nil {
+		return nil, fmt.Errorf("RangeTransformer requires min or max parameter")
+	}
+	if ret.min != nil && ret.max != nil && *ret.min > *ret.max {
+		return nil, fmt.Errorf("invalid range: min %v is greater than max %v", *ret.min, *ret.max)
+	}
+	return ret, nil
+}
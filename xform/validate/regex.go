@@ -0,0 +1,51 @@
+package validate
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"github.com/viant/bindly/locator"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"regexp"
+)
+
+// RegexTransformer validates that text input matches pattern
+type RegexTransformer struct {
+	validatorBase
+	expr *regexp.Regexp
+}
+
+func (t *RegexTransformer) Transform(ctx context.Context, resolver locator.Resolver, input interface{}) (interface{}, error) {
+	if input == nil {
+		return input, nil
+	}
+	err := each(input, func(item interface{}) error {
+		text, ok := asString(item)
+		if !ok {
+			return fmt.Errorf("cannot match %T with pattern %v", item, t.expr)
+		}
+		if !t.expr.MatchString(text) {
+			return fmt.Errorf("value %q does not match pattern %v", text, t.expr)
+		}
+		return nil
+	})
+	return input, err
+}
+
+// NewRegexTransformer creates a new regex transformer, pattern parameter defines regular expression
+func NewRegexTransformer(ctx context.Context, config tags.Values, destType reflect.Type, embedFS *embed.FS) (xform.Transformer, error) {
+	pattern, ok := xform.NewParameters(config).Lookup("pattern")
+	if !ok || pattern == "" {
+		return nil, fmt.Errorf("RegexTransformer requires pattern parameter")
+	}
+	expr, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex pattern: %v, %w", pattern, err)
+	}
+	return &RegexTransformer{
+		validatorBase: validatorBase{TransformerBase: xform.NewTransformerBase("regex", destType, config, embedFS)},
+		expr:          expr,
+	}, nil
+}
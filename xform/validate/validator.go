@@ -0,0 +1,52 @@
+package validate
+
+import (
+	"fmt"
+	"github.com/viant/bindly/xform"
+	"reflect"
+)
+
+// validatorBase represents pass-through transformer base, validator input type is its destination type
+type validatorBase struct {
+	xform.TransformerBase
+}
+
+// InputType returns destination type as validators do not change input
+func (v *validatorBase) InputType() reflect.Type {
+	return v.DestType()
+}
+
+// asString returns string representation of text-like input
+func asString(input interface{}) (string, bool) {
+	switch actual := input.(type) {
+	case string:
+		return actual, true
+	case []byte:
+		return string(actual), true
+	case fmt.Stringer:
+		return actual.String(), true
+	}
+	value := reflect.ValueOf(input)
+	if value.Kind() == reflect.String {
+		return value.String(), true
+	}
+	return "", false
+}
+
+// each calls fn for every slice or array element, or for input itself
+func each(input interface{}, fn func(item interface{}) error) error {
+	if _, ok := input.([]byte); ok {
+		return fn(input)
+	}
+	value := reflect.ValueOf(input)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := fn(value.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fn(input)
+}
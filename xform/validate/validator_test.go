@@ -0,0 +1,61 @@
+package validate_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/viant/bindly/xform"
+	"github.com/viant/bindly/xform/conv"
+	"github.com/viant/bindly/xform/validate"
+	"github.com/viant/tagly/tags"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestValidators(t *testing.T) {
+	registry := xform.NewRegistry()
+	conv.Init(registry)
+	validate.Init(registry)
+
+	var testCases = []struct {
+		description string
+		config      string
+		destType    reflect.Type
+		input       interface{}
+		expect      interface{}
+		expectErr   bool
+	}{
+		{description: "range valid", config: "range,min=1,max=65535", destType: reflect.TypeOf(0), input: 8080, expect: 8080},
+		{description: "range invalid", config: "range,min=1,max=65535", destType: reflect.TypeOf(0), input: 70000, expectErr: true},
+		{description: "range after conversion", config: "uint|range,min=1,max=65535", destType: reflect.TypeOf(uint16(0)), input: "443", expect: uint16(443)},
+		{description: "range conversion error", config: "int|range,min=1", destType: reflect.TypeOf(0), input: "abc", expectErr: true},
+		{description: "range min only", config: "range,min=1", destType: reflect.TypeOf(0), input: 0, expectErr: true},
+		{description: "range duration", config: "duration|range,min=1s,max=1m", destType: reflect.TypeOf(time.Duration(0)), input: "2m", expectErr: true},
+		{description: "regex valid", config: "regex,pattern='^[a-z]{1,3}$'", destType: reflect.TypeOf(""), input: "abc", expect: "abc"},
+		{description: "regex invalid", config: "regex,pattern='^[a-z]{1,3}$'", destType: reflect.TypeOf(""), input: "abcd", expectErr: true},
+		{description: "enum valid", config: "enum,values=dev;staging;prod", destType: reflect.TypeOf(""), input: "prod", expect: "prod"},
+		{description: "enum invalid", config: "enum,values=dev;staging;prod", destType: reflect.TypeOf(""), input: "qa", expectErr: true},
+		{description: "enum ignore case", config: "enum,values=dev;prod,ignoreCase", destType: reflect.TypeOf(""), input: "PROD", expect: "PROD"},
+		{description: "enum slice", config: "enum,values=a;b", destType: reflect.TypeOf([]string{}), input: []string{"a", "c"}, expectErr: true},
+		{description: "len valid", config: "len,min=1", destType: reflect.TypeOf(""), input: "x", expect: "x"},
+		{description: "len too short", config: "len,min=1", destType: reflect.TypeOf(""), input: "", expectErr: true},
+		{description: "len too long", config: "len,max=2", destType: reflect.TypeOf([]int{}), input: []int{1, 2, 3}, expectErr: true},
+		{description: "not empty valid", config: "notEmpty", destType: reflect.TypeOf(""), input: "x", expect: "x"},
+		{description: "not empty blank", config: "notEmpty", destType: reflect.TypeOf(""), input: "  ", expectErr: true},
+		{description: "not empty map", config: "notEmpty", destType: reflect.TypeOf(map[string]int{}), input: map[string]int{}, expectErr: true},
+	}
+
+	for _, testCase := range testCases {
+		transformer, err := registry.Create(context.Background(), tags.Values(testCase.config), testCase.destType, nil)
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		actual, err := transformer.Transform(context.Background(), nil, testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		assert.Nil(t, err, testCase.description)
+		assert.Equal(t, testCase.expect, actual, testCase.description)
+	}
+}